@@ -0,0 +1,273 @@
+package kaiascan
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"kaiascan.go/output"
+)
+
+const accountExportCheckpointFile = "checkpoint.json"
+
+type AccountExportOptions struct {
+	// Format is output.CSV or output.JSONL. Defaults to CSV.
+	Format           output.Format
+	BlockNumberStart *int
+	// BlockNumberEnd defaults to the latest block when the export starts, so
+	// that a resumed export sees the same pages.
+	BlockNumberEnd *int
+	// PageSize defaults to 2000.
+	PageSize int
+	// API is where history is fetched from. A nil API means a Client.
+	API interface {
+		BlocksAPI
+		AccountsAPI
+	}
+}
+
+type AccountExportStream struct {
+	File     string `json:"file"`
+	NextPage int    `json:"nextPage"`
+	Rows     int    `json:"rows"`
+	Offset   int64  `json:"offset"`
+	Done     bool   `json:"done"`
+}
+
+// AccountExportCheckpoint is the progress of an account export, saved to
+// checkpoint.json in the export directory after every page.
+type AccountExportCheckpoint struct {
+	Address          string                          `json:"address"`
+	Format           output.Format                   `json:"format"`
+	BlockNumberStart *int                            `json:"blockNumberStart,omitempty"`
+	BlockNumberEnd   int                             `json:"blockNumberEnd"`
+	PageSize         int                             `json:"pageSize"`
+	Streams          map[string]*AccountExportStream `json:"streams"`
+}
+
+func (c *AccountExportCheckpoint) Done() bool {
+	for _, s := range c.Streams {
+		if !s.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// ExportAccountHistory streams every transaction, token transfer and NFT
+// transfer of an account into transactions, token-transfers and
+// nft-transfers files in dir, one page at a time. If dir holds the checkpoint
+// of an interrupted export of the same account it is resumed: each file is
+// truncated to the last completed page and the walk continues from there.
+func ExportAccountHistory(ctx context.Context, accountAddress string, dir string, opts AccountExportOptions) (*AccountExportCheckpoint, error) {
+	if accountAddress == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating export directory: %w", err)
+	}
+
+	checkpoint, err := loadAccountExportCheckpoint(dir)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint != nil {
+		if err := checkpoint.matches(accountAddress, opts); err != nil {
+			return nil, err
+		}
+	} else {
+		checkpoint, err = newAccountExportCheckpoint(accountAddress, opts)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkpoint.save(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	api := apiOrDefault(opts.API)
+	address, start, end := checkpoint.Address, checkpoint.BlockNumberStart, &checkpoint.BlockNumberEnd
+	err = exportStream(ctx, dir, checkpoint, "transactions", func(page int, size int) (*ApiResponse[Page[Transaction]], error) {
+		return api.GetAccountTransactions(address, page, size, start, end, nil, nil)
+	})
+	if err != nil {
+		return checkpoint, err
+	}
+	err = exportStream(ctx, dir, checkpoint, "token-transfers", func(page int, size int) (*ApiResponse[Page[TokenTransfer]], error) {
+		return api.GetAccountTokenTransfers(address, page, size, nil, start, end)
+	})
+	if err != nil {
+		return checkpoint, err
+	}
+	err = exportStream(ctx, dir, checkpoint, "nft-transfers", func(page int, size int) (*ApiResponse[Page[NftTransfer]], error) {
+		return api.GetAccountNftTransfers(address, page, size, nil, start, end)
+	})
+	if err != nil {
+		return checkpoint, err
+	}
+	return checkpoint, nil
+}
+
+func newAccountExportCheckpoint(accountAddress string, opts AccountExportOptions) (*AccountExportCheckpoint, error) {
+	format := opts.Format
+	if format == "" {
+		format = output.CSV
+	}
+	if format != output.CSV && format != output.JSONL {
+		return nil, fmt.Errorf("unsupported export format %q, expected csv or jsonl", format)
+	}
+	size := opts.PageSize
+	if size == 0 {
+		size = maxPageSize
+	}
+	if size < 1 || size > maxPageSize {
+		return nil, fmt.Errorf("size must be between 1 and 2000")
+	}
+
+	var end int
+	if opts.BlockNumberEnd != nil {
+		end = *opts.BlockNumberEnd
+	} else {
+		latest, err := apiOrDefault(opts.API).GetLatestBlock()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching latest block: %w", err)
+		}
+		end = int(latest.Data.BlockNumber)
+	}
+
+	checkpoint := &AccountExportCheckpoint{
+		Address:          accountAddress,
+		Format:           format,
+		BlockNumberStart: opts.BlockNumberStart,
+		BlockNumberEnd:   end,
+		PageSize:         size,
+		Streams:          map[string]*AccountExportStream{},
+	}
+	for _, name := range []string{"transactions", "token-transfers", "nft-transfers"} {
+		checkpoint.Streams[name] = &AccountExportStream{File: name + "." + string(format), NextPage: 1}
+	}
+	return checkpoint, nil
+}
+
+func (c *AccountExportCheckpoint) matches(accountAddress string, opts AccountExportOptions) error {
+	mismatch := c.Address != accountAddress ||
+		(opts.Format != "" && opts.Format != c.Format) ||
+		(opts.PageSize != 0 && opts.PageSize != c.PageSize) ||
+		(opts.BlockNumberStart != nil && (c.BlockNumberStart == nil || *opts.BlockNumberStart != *c.BlockNumberStart)) ||
+		(opts.BlockNumberEnd != nil && *opts.BlockNumberEnd != c.BlockNumberEnd)
+	if mismatch {
+		return fmt.Errorf("export directory holds a checkpoint for a different export of %s; remove %s to start over", c.Address, accountExportCheckpointFile)
+	}
+	return nil
+}
+
+func loadAccountExportCheckpoint(dir string) (*AccountExportCheckpoint, error) {
+	data, err := os.ReadFile(filepath.Join(dir, accountExportCheckpointFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %w", err)
+	}
+
+	var checkpoint AccountExportCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// save replaces the checkpoint atomically so an interruption never leaves
+// a partially written file behind.
+func (c *AccountExportCheckpoint) save(dir string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(dir, accountExportCheckpointFile)
+	if err := os.WriteFile(target+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("error writing checkpoint: %w", err)
+	}
+	if err := os.Rename(target+".tmp", target); err != nil {
+		return fmt.Errorf("error writing checkpoint: %w", err)
+	}
+	return nil
+}
+
+func exportStream[T any](ctx context.Context, dir string, checkpoint *AccountExportCheckpoint, name string, fetch pageFetcher[T]) (err error) {
+	stream := checkpoint.Streams[name]
+	if stream == nil {
+		return fmt.Errorf("checkpoint has no %s stream", name)
+	}
+	if stream.Done {
+		return nil
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, stream.File), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", stream.File, err)
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+
+	// Anything past the recorded offset belongs to a page that was not
+	// checkpointed and is written again.
+	if err := f.Truncate(stream.Offset); err != nil {
+		return fmt.Errorf("error truncating %s: %w", stream.File, err)
+	}
+	if _, err := f.Seek(stream.Offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	if stream.Offset == 0 && checkpoint.Format == output.CSV {
+		var zero T
+		cw := csv.NewWriter(f)
+		cw.Write(output.Columns(zero))
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return fmt.Errorf("error writing %s: %w", stream.File, err)
+		}
+	}
+
+	err = forEachPageFrom(ctx, stream.NextPage, checkpoint.PageSize, fetch, func(page int, results []T) error {
+		w, err := output.NewWriter(f, checkpoint.Format, output.Options{OmitHeader: true})
+		if err != nil {
+			return err
+		}
+		for _, r := range results {
+			if err := w.Write(r); err != nil {
+				return fmt.Errorf("error writing %s: %w", stream.File, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			return fmt.Errorf("error writing %s: %w", stream.File, err)
+		}
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("error writing %s: %w", stream.File, err)
+		}
+
+		offset, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		stream.NextPage = page + 1
+		stream.Rows += len(results)
+		stream.Offset = offset
+		return checkpoint.save(dir)
+	})
+	if err != nil {
+		return fmt.Errorf("error exporting %s: %w", name, err)
+	}
+
+	stream.Done = true
+	return checkpoint.save(dir)
+}
@@ -0,0 +1,109 @@
+package kaiascan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"kaiascan.go/output"
+)
+
+func TestExportAccountHistory_Resume(t *testing.T) {
+	failTokenPage := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		page, _ := strconv.Atoi(q.Get("page"))
+		if !strings.HasSuffix(r.URL.Path, "/blocks/latest") && q.Get("blockNumberEnd") != "500" {
+			t.Errorf("Expected the pinned block range, got %s", r.URL.RawQuery)
+		}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(Block{BlockNumber: 500}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/transactions"):
+			txs := []Transaction{{TransactionHash: "0xtx1"}}
+			w.Write(mockApiResponse(Page[Transaction]{Paging: Paging{Last: true}, Results: txs}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/token-transfers"):
+			if page == failTokenPage {
+				failTokenPage = 0
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			transfers := []TokenTransfer{
+				{TransactionHash: fmt.Sprintf("0xp%da", page), Amount: amountOf(1)},
+				{TransactionHash: fmt.Sprintf("0xp%db", page), Amount: amountOf(2)},
+			}
+			w.Write(mockApiResponse(Page[TokenTransfer]{Paging: Paging{TotalPage: 3}, Results: transfers}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/nft-transfers"):
+			w.Write(mockApiResponse(Page[NftTransfer]{Paging: Paging{Last: true}}, 0, "Success"))
+		default:
+			t.Errorf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	dir := t.TempDir()
+	opts := AccountExportOptions{PageSize: 2}
+
+	checkpoint, err := ExportAccountHistory(context.Background(), "0xwallet", dir, opts)
+	if err == nil {
+		t.Fatal("Expected the first export to fail")
+	}
+	if checkpoint.Streams["token-transfers"].NextPage != 2 || checkpoint.Done() {
+		t.Fatalf("Unexpected checkpoint: %+v", checkpoint.Streams["token-transfers"])
+	}
+	// Simulate a page that was partly written before the interruption.
+	f, _ := os.OpenFile(filepath.Join(dir, "token-transfers.csv"), os.O_APPEND|os.O_WRONLY, 0)
+	f.WriteString("0xpartial,")
+	f.Close()
+
+	checkpoint, err = ExportAccountHistory(context.Background(), "0xwallet", dir, opts)
+	if err != nil {
+		t.Fatalf("Expected the resumed export to succeed, got %v", err)
+	}
+	if !checkpoint.Done() || checkpoint.Streams["token-transfers"].Rows != 6 || checkpoint.Streams["transactions"].Rows != 1 {
+		t.Fatalf("Unexpected checkpoint: %+v", checkpoint)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "token-transfers.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 7 || !strings.HasPrefix(lines[0], "transactionHash,logIndex,") {
+		t.Fatalf("Unexpected export:\n%s", data)
+	}
+	for i, want := range []string{"0xp1a", "0xp1b", "0xp2a", "0xp2b", "0xp3a", "0xp3b"} {
+		if !strings.HasPrefix(lines[i+1], want+",") {
+			t.Errorf("Line %d: expected %s, got %s", i+1, want, lines[i+1])
+		}
+	}
+
+	nft, _ := os.ReadFile(filepath.Join(dir, "nft-transfers.csv"))
+	if !strings.HasPrefix(string(nft), "transactionHash,") {
+		t.Errorf("Expected a header for an empty export, got %q", nft)
+	}
+}
+
+func TestExportAccountHistory_CheckpointMismatch(t *testing.T) {
+	dir := t.TempDir()
+	end := 100
+	checkpoint, err := newAccountExportCheckpoint("0xwallet", AccountExportOptions{BlockNumberEnd: &end})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkpoint.save(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ExportAccountHistory(context.Background(), "0xwallet", dir, AccountExportOptions{Format: output.JSONL})
+	if err == nil || !strings.Contains(err.Error(), "different export") {
+		t.Errorf("Expected a checkpoint mismatch, got %v", err)
+	}
+}
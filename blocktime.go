@@ -0,0 +1,154 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrBlockNotFound = errors.New("no block matches the requested time")
+
+type BlockRange struct {
+	First Block
+	Last  Block
+}
+
+func BlockAtOrAfter(ctx context.Context, t time.Time) (*Block, error) {
+	return blockAtOrAfter(ctx, NewClient(), t)
+}
+
+func BlockAtOrBefore(ctx context.Context, t time.Time) (*Block, error) {
+	return blockAtOrBefore(ctx, NewClient(), t)
+}
+
+func blockAtOrAfter(ctx context.Context, api BlocksAPI, t time.Time) (*Block, error) {
+	ts := t.Unix()
+	if t.Nanosecond() > 0 {
+		ts++
+	}
+	block, err := blockAtTimestamp(ctx, api, ts, false)
+	if err != nil || block != nil {
+		return block, err
+	}
+
+	latest, err := api.GetLatestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest block: %w", err)
+	}
+	if latest.Data.Datetime.Before(t) {
+		return nil, fmt.Errorf("%w: latest block %d is at %s, before %s", ErrBlockNotFound, latest.Data.BlockNumber, latest.Data.Datetime.Format(time.RFC3339), t.Format(time.RFC3339))
+	}
+
+	return searchBlocks(ctx, api, latest.Data, func(b Block) bool { return !b.Datetime.Before(t) })
+}
+
+func blockAtOrBefore(ctx context.Context, api BlocksAPI, t time.Time) (*Block, error) {
+	block, err := blockAtTimestamp(ctx, api, t.Unix(), true)
+	if err != nil || block != nil {
+		return block, err
+	}
+
+	latest, err := api.GetLatestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest block: %w", err)
+	}
+	if !latest.Data.Datetime.After(t) {
+		return &latest.Data, nil
+	}
+
+	first, err := searchBlocks(ctx, api, latest.Data, func(b Block) bool { return b.Datetime.After(t) })
+	if err != nil {
+		return nil, err
+	}
+	if first.BlockNumber == 0 {
+		return nil, fmt.Errorf("%w: genesis block is after %s", ErrBlockNotFound, t.Format(time.RFC3339))
+	}
+	return fetchBlockContext(ctx, api, first.BlockNumber-1)
+}
+
+// BlockRangeForInterval returns the first and last blocks produced in the
+// half-open interval [start, end).
+func BlockRangeForInterval(ctx context.Context, start time.Time, end time.Time) (*BlockRange, error) {
+	return blockRangeForInterval(ctx, NewClient(), start, end)
+}
+
+func blockRangeForInterval(ctx context.Context, api BlocksAPI, start time.Time, end time.Time) (*BlockRange, error) {
+	if !start.Before(end) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+
+	first, err := blockAtOrAfter(ctx, api, start)
+	if err != nil {
+		return nil, err
+	}
+	last, err := blockAtOrBefore(ctx, api, end.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	if last.BlockNumber < first.BlockNumber {
+		return nil, fmt.Errorf("%w: no blocks between %s and %s", ErrBlockNotFound, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	return &BlockRange{First: *first, Last: *last}, nil
+}
+
+// blockAtTimestamp asks the timestamp endpoint for blocks produced in the
+// given second. A miss returns a nil block and no error so the caller can
+// fall back to searching; any other failure is returned.
+func blockAtTimestamp(ctx context.Context, api BlocksAPI, timestamp int64, latest bool) (*Block, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	resp, err := api.GetBlocksByTimestamp(timestamp)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching blocks at timestamp %d: %w", timestamp, err)
+	}
+	if len(resp.Data) == 0 {
+		return nil, nil
+	}
+
+	best := resp.Data[0]
+	for _, b := range resp.Data[1:] {
+		if (latest && b.BlockNumber > best.BlockNumber) || (!latest && b.BlockNumber < best.BlockNumber) {
+			best = b
+		}
+	}
+	return &best, nil
+}
+
+// searchBlocks returns the lowest-numbered block for which match is true,
+// given that match is monotonic in block number and holds for latest.
+func searchBlocks(ctx context.Context, api BlocksAPI, latest Block, match func(Block) bool) (*Block, error) {
+	lo, hi := int64(0), latest.BlockNumber
+	found := latest
+
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		block, err := fetchBlockContext(ctx, api, mid)
+		if err != nil {
+			return nil, err
+		}
+		if match(*block) {
+			hi = mid
+			found = *block
+		} else {
+			lo = mid + 1
+		}
+	}
+	return &found, nil
+}
+
+func fetchBlockContext(ctx context.Context, api BlocksAPI, blockNumber int64) (*Block, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	block, err := fetchBlock(api, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
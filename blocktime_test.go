@@ -0,0 +1,126 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testGenesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newTimedChainServer serves blocks 0..head, block n being produced two
+// seconds after block n-1, so odd-second timestamps fall between blocks.
+func newTimedChainServer(t *testing.T, head int64) *httptest.Server {
+	blockAt := func(n int64) Block {
+		return Block{BlockNumber: n, Datetime: testGenesisTime.Add(time.Duration(2*n) * time.Second)}
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(blockAt(head), 0, "Success"))
+		case strings.Contains(r.URL.Path, "/blocks/timestamps/"):
+			ts, _ := strconv.ParseInt(path.Base(r.URL.Path), 10, 64)
+			offset := ts - testGenesisTime.Unix()
+			if offset < 0 || offset%2 != 0 || offset/2 > head {
+				w.Write(mockApiResponse([]Block{}, 0, "Success"))
+				return
+			}
+			w.Write(mockApiResponse([]Block{blockAt(offset / 2)}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/blocks"):
+			n, _ := strconv.ParseInt(r.URL.Query().Get("blockNumber"), 10, 64)
+			w.Write(mockApiResponse(blockAt(n), 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestBlockAtOrAfterAndBefore(t *testing.T) {
+	server := newTimedChainServer(t, 1000)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+	ctx := context.Background()
+
+	exact := testGenesisTime.Add(200 * time.Second)
+	between := testGenesisTime.Add(201 * time.Second)
+
+	block, err := BlockAtOrAfter(ctx, exact)
+	if err != nil || block.BlockNumber != 100 {
+		t.Fatalf("Expected block 100 at exact timestamp, got %+v, %v", block, err)
+	}
+
+	block, err = BlockAtOrAfter(ctx, between)
+	if err != nil || block.BlockNumber != 101 {
+		t.Fatalf("Expected block 101 after %s, got %+v, %v", between, block, err)
+	}
+
+	block, err = BlockAtOrBefore(ctx, between)
+	if err != nil || block.BlockNumber != 100 {
+		t.Fatalf("Expected block 100 before %s, got %+v, %v", between, block, err)
+	}
+
+	if _, err := BlockAtOrAfter(ctx, testGenesisTime.Add(time.Hour)); !errors.Is(err, ErrBlockNotFound) {
+		t.Errorf("Expected ErrBlockNotFound for a future time, got %v", err)
+	}
+	if _, err := BlockAtOrBefore(ctx, testGenesisTime.Add(-time.Second)); !errors.Is(err, ErrBlockNotFound) {
+		t.Errorf("Expected ErrBlockNotFound before genesis, got %v", err)
+	}
+}
+
+func TestBlockRangeForInterval(t *testing.T) {
+	server := newTimedChainServer(t, 1000)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	start := testGenesisTime.Add(11 * time.Second)
+	end := testGenesisTime.Add(20 * time.Second)
+
+	blockRange, err := BlockRangeForInterval(context.Background(), start, end)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if blockRange.First.BlockNumber != 6 || blockRange.Last.BlockNumber != 9 {
+		t.Errorf("Expected blocks 6..9, got %d..%d", blockRange.First.BlockNumber, blockRange.Last.BlockNumber)
+	}
+}
+
+func TestBlockAtOrAfter_Errors(t *testing.T) {
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/blocks/timestamps/") && status != http.StatusOK:
+			w.WriteHeader(status)
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(Block{BlockNumber: 0, Datetime: testGenesisTime}, 0, "Success"))
+		default:
+			w.Write(mockApiResponse([]Block{}, 0, "Success"))
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	status = http.StatusServiceUnavailable
+	var httpErr *HTTPError
+	if _, err := BlockAtOrAfter(context.Background(), testGenesisTime); !errors.As(err, &httpErr) {
+		t.Errorf("Expected the HTTP error to be returned, got %v", err)
+	}
+
+	status = http.StatusNotFound
+	block, err := BlockAtOrAfter(context.Background(), testGenesisTime)
+	if err != nil || block.BlockNumber != 0 {
+		t.Errorf("Expected a 404 to fall back to searching, got %+v, %v", block, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := BlockAtOrBefore(ctx, testGenesisTime); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
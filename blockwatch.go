@@ -0,0 +1,238 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+var ErrReorgTooDeep = errors.New("reorg deeper than tracked block history")
+
+type BlockEventType int
+
+const (
+	BlockAdded BlockEventType = iota
+	BlockReorg
+)
+
+func (t BlockEventType) String() string {
+	switch t {
+	case BlockAdded:
+		return "added"
+	case BlockReorg:
+		return "reorg"
+	default:
+		return fmt.Sprintf("BlockEventType(%d)", int(t))
+	}
+}
+
+// BlockEvent is emitted by a BlockWatcher. For BlockAdded events Block is the
+// new canonical block. For BlockReorg events Removed lists the blocks that are
+// no longer canonical and Added lists their replacements plus any new blocks
+// on top, both in ascending block number order.
+type BlockEvent struct {
+	Type    BlockEventType
+	Block   *Block
+	Removed []Block
+	Added   []Block
+}
+
+type BlockWatcher struct {
+	PollInterval  time.Duration
+	MaxReorgDepth int
+	// API is where blocks are fetched from. NewBlockWatcher sets it to a
+	// Client; a nil API also means a Client.
+	API BlocksAPI
+	// OnError, if set, receives the poll errors that Watch recovers from.
+	// Without it they are logged through the logger set by ConfigureLogger.
+	OnError func(error)
+
+	tracked []Block
+	behind  bool
+}
+
+func NewBlockWatcher(pollInterval time.Duration, maxReorgDepth int) *BlockWatcher {
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	if maxReorgDepth < 1 {
+		maxReorgDepth = 64
+	}
+	return &BlockWatcher{PollInterval: pollInterval, MaxReorgDepth: maxReorgDepth, API: NewClient()}
+}
+
+func (w *BlockWatcher) Tracked() []Block {
+	return append([]Block(nil), w.tracked...)
+}
+
+// Watch polls every PollInterval and passes the events to handle until ctx
+// is cancelled or handle returns an error. A failed poll is reported to
+// OnError and retried on the next tick, except ErrReorgTooDeep, which the
+// watcher cannot recover from and returns. While catching up after falling
+// behind it polls again without waiting for the tick.
+func (w *BlockWatcher) Watch(ctx context.Context, handle func(BlockEvent) error) error {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := w.Poll(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, ErrReorgTooDeep):
+			return err
+		case err != nil:
+			w.reportError(err)
+		}
+		for _, event := range events {
+			if err := handle(event); err != nil {
+				return err
+			}
+		}
+		if err == nil && w.behind {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the chain head and returns the events needed to move the
+// tracked blocks to it. It fetches at most MaxReorgDepth blocks past the
+// tracked tip; a watcher further behind catches up over several polls.
+func (w *BlockWatcher) Poll(ctx context.Context) ([]BlockEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	resp, err := w.api().GetLatestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest block: %w", err)
+	}
+	return w.advance(ctx, resp.Data)
+}
+
+func (w *BlockWatcher) advance(ctx context.Context, head Block) ([]BlockEvent, error) {
+	w.behind = false
+	if len(w.tracked) == 0 {
+		w.tracked = []Block{head}
+		return []BlockEvent{{Type: BlockAdded, Block: &head}}, nil
+	}
+
+	if known, ok := w.trackedAt(head.BlockNumber); ok && known.Hash == head.Hash {
+		return nil, nil
+	}
+
+	tip := w.tracked[len(w.tracked)-1]
+	if step := int64(max(w.MaxReorgDepth, 1)); head.BlockNumber-tip.BlockNumber > step {
+		next, err := fetchBlock(w.api(), tip.BlockNumber+step)
+		if err != nil {
+			return nil, err
+		}
+		head = next
+		w.behind = true
+	}
+
+	// segment collects blocks from head downwards and is reversed once the
+	// common ancestor is found.
+	segment := []Block{head}
+	cur := head
+
+	for cur.BlockNumber-1 > tip.BlockNumber {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		parent, err := fetchBlock(w.api(), cur.BlockNumber-1)
+		if err != nil {
+			return nil, err
+		}
+		if parent.Hash != cur.ParentHash {
+			return nil, fmt.Errorf("block %d parent hash mismatch while catching up, chain is still changing", cur.BlockNumber)
+		}
+		segment = append(segment, parent)
+		cur = parent
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		known, ok := w.trackedAt(cur.BlockNumber - 1)
+		if !ok {
+			return nil, fmt.Errorf("%w: no common ancestor for block %d within %d blocks", ErrReorgTooDeep, head.BlockNumber, len(w.tracked))
+		}
+		if known.Hash == cur.ParentHash {
+			break
+		}
+		parent, err := fetchBlock(w.api(), cur.BlockNumber-1)
+		if err != nil {
+			return nil, err
+		}
+		segment = append(segment, parent)
+		cur = parent
+	}
+	slices.Reverse(segment)
+
+	cut := len(w.tracked)
+	for i, b := range w.tracked {
+		if b.BlockNumber >= segment[0].BlockNumber {
+			cut = i
+			break
+		}
+	}
+	removed := append([]Block(nil), w.tracked[cut:]...)
+
+	w.tracked = append(w.tracked[:cut], segment...)
+	if extra := len(w.tracked) - w.MaxReorgDepth; extra > 0 {
+		w.tracked = append([]Block(nil), w.tracked[extra:]...)
+	}
+
+	if len(removed) > 0 {
+		return []BlockEvent{{Type: BlockReorg, Removed: removed, Added: segment}}, nil
+	}
+
+	events := make([]BlockEvent, 0, len(segment))
+	for i := range segment {
+		events = append(events, BlockEvent{Type: BlockAdded, Block: &segment[i]})
+	}
+	return events, nil
+}
+
+func (w *BlockWatcher) trackedAt(blockNumber int64) (Block, bool) {
+	if len(w.tracked) == 0 {
+		return Block{}, false
+	}
+	i := blockNumber - w.tracked[0].BlockNumber
+	if i < 0 || i >= int64(len(w.tracked)) {
+		return Block{}, false
+	}
+	return w.tracked[i], true
+}
+
+func (w *BlockWatcher) reportError(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+		return
+	}
+	if logger != nil {
+		logger.LogAttrs(context.Background(), logLevels.Failure, "kaiascan block watcher poll failed", slog.String("error", redactError(err).Error()))
+	}
+}
+
+func (w *BlockWatcher) api() BlocksAPI {
+	return apiOrDefault(w.API)
+}
+
+func fetchBlock(api BlocksAPI, blockNumber int64) (Block, error) {
+	resp, err := api.GetBlock(blockNumber)
+	if err != nil {
+		return Block{}, fmt.Errorf("error fetching block %d: %w", blockNumber, err)
+	}
+	return resp.Data, nil
+}
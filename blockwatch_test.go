@@ -0,0 +1,204 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mockChain struct {
+	blocks   map[int64]Block
+	head     int64
+	failures int
+	fetches  int
+}
+
+func (c *mockChain) set(number int64, hash, parentHash string) {
+	c.blocks[number] = Block{BlockNumber: number, Hash: hash, ParentHash: parentHash}
+	c.head = number
+}
+
+func newMockChainServer(t *testing.T, chain *mockChain) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			if chain.failures > 0 {
+				chain.failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write(mockApiResponse(chain.blocks[chain.head], 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/blocks"):
+			chain.fetches++
+			n, _ := strconv.ParseInt(r.URL.Query().Get("blockNumber"), 10, 64)
+			block, ok := chain.blocks[n]
+			if !ok {
+				w.Write(mockApiResponse(Block{}, 404, "block not found"))
+				return
+			}
+			w.Write(mockApiResponse(block, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestBlockWatcher_FollowsChain(t *testing.T) {
+	chain := &mockChain{blocks: map[int64]Block{}}
+	chain.set(10, "0xa10", "0xa9")
+
+	server := newMockChainServer(t, chain)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	watcher := NewBlockWatcher(0, 8)
+	events, err := watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(events) != 1 || events[0].Type != BlockAdded || events[0].Block.Hash != "0xa10" {
+		t.Fatalf("Unexpected initial events: %+v", events)
+	}
+
+	chain.set(11, "0xa11", "0xa10")
+	chain.set(12, "0xa12", "0xa11")
+	events, err = watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(events) != 2 || events[0].Block.BlockNumber != 11 || events[1].Block.BlockNumber != 12 {
+		t.Fatalf("Expected blocks 11 and 12 to be added, got %+v", events)
+	}
+
+	events, err = watcher.Poll(context.Background())
+	if err != nil || len(events) != 0 {
+		t.Fatalf("Expected no events for unchanged head, got %+v, %v", events, err)
+	}
+}
+
+func TestBlockWatcher_DetectsReorg(t *testing.T) {
+	chain := &mockChain{blocks: map[int64]Block{}}
+	chain.set(10, "0xa10", "0xa9")
+
+	server := newMockChainServer(t, chain)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	watcher := NewBlockWatcher(0, 8)
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, n := range []int64{11, 12} {
+		chain.set(n, fmt.Sprintf("0xa%d", n), fmt.Sprintf("0xa%d", n-1))
+	}
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	chain.set(11, "0xb11", "0xa10")
+	chain.set(12, "0xb12", "0xb11")
+	chain.set(13, "0xb13", "0xb12")
+
+	events, err := watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(events) != 1 || events[0].Type != BlockReorg {
+		t.Fatalf("Expected a single reorg event, got %+v", events)
+	}
+	reorg := events[0]
+	if len(reorg.Removed) != 2 || reorg.Removed[0].Hash != "0xa11" || reorg.Removed[1].Hash != "0xa12" {
+		t.Errorf("Unexpected removed blocks: %+v", reorg.Removed)
+	}
+	if len(reorg.Added) != 3 || reorg.Added[0].Hash != "0xb11" || reorg.Added[2].Hash != "0xb13" {
+		t.Errorf("Unexpected added blocks: %+v", reorg.Added)
+	}
+
+	tracked := watcher.Tracked()
+	if tracked[len(tracked)-1].Hash != "0xb13" {
+		t.Errorf("Expected tracked tip 0xb13, got %s", tracked[len(tracked)-1].Hash)
+	}
+}
+
+func TestBlockWatcher_CatchesUpInSteps(t *testing.T) {
+	chain := &mockChain{blocks: map[int64]Block{}}
+	chain.set(10, "0xa10", "0xa9")
+
+	server := newMockChainServer(t, chain)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	watcher := NewBlockWatcher(0, 4)
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for n := int64(11); n <= 20; n++ {
+		chain.set(n, fmt.Sprintf("0xa%d", n), fmt.Sprintf("0xa%d", n-1))
+	}
+
+	var added []int64
+	for _, want := range []int{4, 4, 2} {
+		chain.fetches = 0
+		events, err := watcher.Poll(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(events) != want || chain.fetches > 4 {
+			t.Fatalf("Expected %d events from at most 4 fetches, got %d events from %d fetches", want, len(events), chain.fetches)
+		}
+		for _, e := range events {
+			added = append(added, e.Block.BlockNumber)
+		}
+	}
+	for i, n := range added {
+		if n != int64(11+i) {
+			t.Fatalf("Expected blocks 11 to 20 in order, got %v", added)
+		}
+	}
+}
+
+func TestBlockWatcher_WatchSurvivesPollErrors(t *testing.T) {
+	chain := &mockChain{blocks: map[int64]Block{}, failures: 2}
+	chain.set(10, "0xa10", "0xa9")
+
+	server := newMockChainServer(t, chain)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := NewBlockWatcher(time.Millisecond, 8)
+	var pollErrors int
+	watcher.OnError = func(error) { pollErrors++ }
+	err := watcher.Watch(ctx, func(e BlockEvent) error {
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the watch to end on cancellation, got %v", err)
+	}
+	if pollErrors != 2 {
+		t.Errorf("Expected 2 reported poll errors, got %d", pollErrors)
+	}
+
+	watcher = NewBlockWatcher(time.Millisecond, 2)
+	watcher.OnError = func(err error) { t.Errorf("Unexpected recoverable error: %v", err) }
+	chain.set(11, "0xa11", "0xa10")
+	err = watcher.Watch(context.Background(), func(e BlockEvent) error {
+		if e.Type == BlockAdded && e.Block.BlockNumber == 11 {
+			chain.set(11, "0xb11", "0xb10")
+			chain.set(12, "0xb12", "0xb11")
+		}
+		return nil
+	})
+	if !errors.Is(err, ErrReorgTooDeep) {
+		t.Errorf("Expected ErrReorgTooDeep to end the watch, got %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+package bytecode
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const wordSize = 32
+
+// Argument describes an ABI parameter, mirroring the name, type and
+// components fields of an ABI JSON input.
+type Argument struct {
+	Name       string
+	Type       string
+	Components []Argument
+}
+
+// Value is a decoded argument. Integers decode to *big.Int, addresses to
+// 0x-prefixed hex strings, bool to bool, bytes and bytesN to []byte, string to
+// string, arrays to []any and tuples to []Value.
+type Value struct {
+	Name  string
+	Type  string
+	Value any
+}
+
+type abiType struct {
+	base       string
+	size       int
+	dims       []int
+	components []Argument
+}
+
+// DecodeArguments decodes ABI-encoded data, such as constructor arguments,
+// against the given parameter list.
+func DecodeArguments(args []Argument, data []byte) ([]Value, error) {
+	return decodeTuple(args, data)
+}
+
+func parseType(arg Argument) (abiType, error) {
+	t := abiType{components: arg.Components}
+	base := arg.Type
+
+	for strings.HasSuffix(base, "]") {
+		open := strings.LastIndex(base, "[")
+		if open < 0 {
+			return t, fmt.Errorf("invalid type %q", arg.Type)
+		}
+		dim := -1
+		if inner := base[open+1 : len(base)-1]; inner != "" {
+			n, err := strconv.Atoi(inner)
+			if err != nil || n < 0 {
+				return t, fmt.Errorf("invalid array size in %q", arg.Type)
+			}
+			dim = n
+		}
+		t.dims = append([]int{dim}, t.dims...)
+		base = base[:open]
+	}
+
+	switch {
+	case base == "address", base == "bool", base == "string", base == "bytes", base == "tuple", base == "function":
+	case strings.HasPrefix(base, "uint"), strings.HasPrefix(base, "int"):
+		digits := strings.TrimPrefix(strings.TrimPrefix(base, "u"), "int")
+		t.size = 256
+		if digits != "" {
+			n, err := strconv.Atoi(digits)
+			if err != nil || n <= 0 || n > 256 || n%8 != 0 {
+				return t, fmt.Errorf("invalid integer type %q", arg.Type)
+			}
+			t.size = n
+		}
+		base = strings.TrimSuffix(base, digits)
+	case strings.HasPrefix(base, "bytes"):
+		n, err := strconv.Atoi(strings.TrimPrefix(base, "bytes"))
+		if err != nil || n <= 0 || n > 32 {
+			return t, fmt.Errorf("invalid fixed bytes type %q", arg.Type)
+		}
+		t.size = n
+		base = "bytesN"
+	default:
+		return t, fmt.Errorf("unsupported type %q", arg.Type)
+	}
+	t.base = base
+	return t, nil
+}
+
+func (t abiType) elem() abiType {
+	e := t
+	e.dims = t.dims[:len(t.dims)-1]
+	return e
+}
+
+func (t abiType) dynamic() bool {
+	if len(t.dims) > 0 {
+		return t.dims[len(t.dims)-1] < 0 || t.elem().dynamic()
+	}
+	switch t.base {
+	case "string", "bytes":
+		return true
+	case "tuple":
+		for _, c := range t.components {
+			ct, err := parseType(c)
+			if err != nil || ct.dynamic() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t abiType) headSize() int {
+	if t.dynamic() {
+		return wordSize
+	}
+	if len(t.dims) > 0 {
+		return t.dims[len(t.dims)-1] * t.elem().headSize()
+	}
+	if t.base == "tuple" {
+		size := 0
+		for _, c := range t.components {
+			ct, _ := parseType(c)
+			size += ct.headSize()
+		}
+		return size
+	}
+	return wordSize
+}
+
+func decodeTuple(args []Argument, data []byte) ([]Value, error) {
+	values := make([]Value, 0, len(args))
+	offset := 0
+
+	for _, arg := range args {
+		t, err := parseType(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		var v any
+		if t.dynamic() {
+			ptr, err := readLength(data, offset)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", arg.Name, err)
+			}
+			v, err = decodeValue(t, data[ptr:])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", arg.Name, err)
+			}
+		} else {
+			if offset > len(data) {
+				return nil, fmt.Errorf("%s: data too short", arg.Name)
+			}
+			v, err = decodeValue(t, data[offset:])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", arg.Name, err)
+			}
+		}
+
+		values = append(values, Value{Name: arg.Name, Type: arg.Type, Value: v})
+		offset += t.headSize()
+	}
+	return values, nil
+}
+
+func decodeValue(t abiType, data []byte) (any, error) {
+	if len(t.dims) > 0 {
+		elem := t.elem()
+		n := t.dims[len(t.dims)-1]
+		if n < 0 {
+			length, err := readLength(data, 0)
+			if err != nil {
+				return nil, err
+			}
+			n, data = length, data[wordSize:]
+		}
+		// Every element takes at least one head word, so a count beyond the
+		// remaining data is corrupt and must not size an allocation.
+		if n > len(data)/wordSize {
+			return nil, fmt.Errorf("array of %d elements exceeds data", n)
+		}
+
+		elemArg := Argument{Type: elem.String(), Components: t.components}
+		args := make([]Argument, n)
+		for i := range args {
+			args[i] = elemArg
+		}
+		decoded, err := decodeTuple(args, data)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]any, len(decoded))
+		for i, d := range decoded {
+			items[i] = d.Value
+		}
+		return items, nil
+	}
+
+	if t.base == "tuple" {
+		return decodeTuple(t.components, data)
+	}
+
+	if len(data) < wordSize {
+		return nil, fmt.Errorf("data too short for %s", t)
+	}
+	word := data[:wordSize]
+
+	switch t.base {
+	case "uint":
+		return new(big.Int).SetBytes(word), nil
+	case "int":
+		v := new(big.Int).SetBytes(word)
+		if word[0]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		return v, nil
+	case "address":
+		return "0x" + hex.EncodeToString(word[12:]), nil
+	case "bool":
+		return new(big.Int).SetBytes(word).Sign() != 0, nil
+	case "bytesN":
+		return append([]byte(nil), word[:t.size]...), nil
+	case "function":
+		return append([]byte(nil), word[:24]...), nil
+	case "bytes", "string":
+		length, err := readLength(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		if wordSize+length > len(data) {
+			return nil, fmt.Errorf("%s length %d exceeds data", t.base, length)
+		}
+		b := data[wordSize : wordSize+length]
+		if t.base == "string" {
+			return string(b), nil
+		}
+		return append([]byte(nil), b...), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func (t abiType) String() string {
+	var b strings.Builder
+	switch t.base {
+	case "uint", "int":
+		fmt.Fprintf(&b, "%s%d", t.base, t.size)
+	case "bytesN":
+		fmt.Fprintf(&b, "bytes%d", t.size)
+	default:
+		b.WriteString(t.base)
+	}
+	for _, d := range t.dims {
+		if d < 0 {
+			b.WriteString("[]")
+		} else {
+			fmt.Fprintf(&b, "[%d]", d)
+		}
+	}
+	return b.String()
+}
+
+// readLength reads the word at offset as a length or offset, bounded by the
+// size of data so corrupt input cannot trigger huge allocations.
+func readLength(data []byte, offset int) (int, error) {
+	if offset < 0 || offset+wordSize > len(data) {
+		return 0, fmt.Errorf("data too short")
+	}
+	v := new(big.Int).SetBytes(data[offset : offset+wordSize])
+	if !v.IsInt64() || v.Int64() > int64(len(data)) {
+		return 0, fmt.Errorf("offset or length %s out of range", v)
+	}
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,82 @@
+package bytecode
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func words(ws ...string) []byte {
+	var s strings.Builder
+	for _, w := range ws {
+		s.WriteString(strings.Repeat("0", 64-len(w)) + w)
+	}
+	b, _ := hex.DecodeString(s.String())
+	return b
+}
+
+func TestDecodeArguments(t *testing.T) {
+	args := []Argument{
+		{Name: "supply", Type: "uint256"},
+		{Name: "name", Type: "string"},
+		{Name: "owner", Type: "address"},
+		{Name: "delta", Type: "int8"},
+		{Name: "holders", Type: "address[]"},
+		{Name: "config", Type: "tuple", Components: []Argument{{Name: "enabled", Type: "bool"}, {Name: "tag", Type: "bytes4"}}},
+	}
+
+	data := words(
+		"2a",  // supply
+		"100", // offset of name
+		"00000000000000000000000000000000000000ab", // owner
+		strings.Repeat("f", 64),                    // delta = -1
+		"140",                                      // offset of holders
+		"1",                                        // config.enabled
+		"deadbeef"+strings.Repeat("0", 56),         // config.tag
+		"0",                                        // padding to reach offset 0x100
+		"2",                                        // name length
+		"6869"+strings.Repeat("0", 60),             // "hi"
+		"2",                                        // holders length
+		"01",
+		"02",
+	)
+
+	values, err := DecodeArguments(args, data)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if values[0].Value.(*big.Int).Int64() != 42 {
+		t.Errorf("Unexpected supply: %v", values[0].Value)
+	}
+	if values[1].Value != "hi" {
+		t.Errorf("Unexpected name: %v", values[1].Value)
+	}
+	if values[2].Value != "0x00000000000000000000000000000000000000ab" {
+		t.Errorf("Unexpected owner: %v", values[2].Value)
+	}
+	if values[3].Value.(*big.Int).Int64() != -1 {
+		t.Errorf("Unexpected delta: %v", values[3].Value)
+	}
+	holders := values[4].Value.([]any)
+	if len(holders) != 2 || holders[1] != "0x0000000000000000000000000000000000000002" {
+		t.Errorf("Unexpected holders: %v", holders)
+	}
+	config := values[5].Value.([]Value)
+	if config[0].Value != true || hex.EncodeToString(config[1].Value.([]byte)) != "deadbeef" {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+}
+
+func TestDecodeArguments_Truncated(t *testing.T) {
+	if _, err := DecodeArguments([]Argument{{Name: "name", Type: "string"}}, words("20", "ff")); err == nil {
+		t.Error("Expected an error for a string longer than the data")
+	}
+	if _, err := DecodeArguments([]Argument{{Name: "huge", Type: "uint256[1000000000]"}}, words("1", "2")); err == nil {
+		t.Error("Expected an error for a fixed array larger than the data")
+	}
+	if _, err := DecodeArguments([]Argument{{Name: "items", Type: "uint256[]"}}, words("20", "40", "1")); err == nil {
+		t.Error("Expected an error for a dynamic array longer than the data")
+	}
+}
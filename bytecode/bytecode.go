@@ -0,0 +1,94 @@
+package bytecode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrRuntimeNotFound = errors.New("runtime code not found in creation code")
+
+const (
+	opPush1   = 0x60
+	opPush32  = 0x7f
+	opReturn  = 0xf3
+	opInvalid = 0xfe
+)
+
+// Analysis is creation code split into its parts: the init code run at
+// deployment, the runtime code it returns, and the ABI-encoded constructor
+// arguments appended after it.
+type Analysis struct {
+	InitCode        []byte
+	RuntimeCode     []byte
+	ConstructorArgs []byte
+	Metadata        *Metadata
+}
+
+func DecodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex bytecode: %w", err)
+	}
+	return b, nil
+}
+
+// Analyze splits creation code. When the deployed runtime code is known it
+// may be passed to locate the boundary exactly; otherwise the runtime is taken
+// to start after the first RETURN INVALID sequence of the init code and to end
+// after the last metadata trailer.
+func Analyze(creation []byte, runtime []byte) (*Analysis, error) {
+	if len(creation) == 0 {
+		return nil, fmt.Errorf("creation code is empty")
+	}
+
+	if len(runtime) > 0 {
+		if start := bytes.Index(creation, runtime); start >= 0 {
+			end := start + len(runtime)
+			analysis := split(creation, start, end)
+			analysis.Metadata, _ = ParseMetadata(analysis.RuntimeCode)
+			return analysis, nil
+		}
+	}
+
+	start, ok := runtimeStart(creation)
+	if !ok {
+		return nil, ErrRuntimeNotFound
+	}
+
+	metadata, end, found := findMetadata(creation[start:])
+	if !found {
+		return &Analysis{InitCode: creation[:start], RuntimeCode: creation[start:]}, nil
+	}
+
+	analysis := split(creation, start, start+end)
+	analysis.Metadata = metadata
+	return analysis, nil
+}
+
+func split(creation []byte, start int, end int) *Analysis {
+	return &Analysis{
+		InitCode:        creation[:start],
+		RuntimeCode:     creation[start:end],
+		ConstructorArgs: creation[end:],
+	}
+}
+
+// runtimeStart walks the init code opcodes, skipping push data, and returns
+// the offset after the first RETURN immediately followed by INVALID.
+func runtimeStart(code []byte) (int, bool) {
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op >= opPush1 && op <= opPush32 {
+			pc += int(op-opPush1) + 1
+			continue
+		}
+		if op == opReturn && pc+1 < len(code) && code[pc+1] == opInvalid {
+			return pc + 2, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+package bytecode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func testRuntime() []byte {
+	cbor := []byte{0xa2, 0x64}
+	cbor = append(cbor, "ipfs"...)
+	cbor = append(cbor, 0x58, 0x22, 0x12, 0x20)
+	cbor = append(cbor, bytes.Repeat([]byte{0x01}, 32)...)
+	cbor = append(cbor, 0x64)
+	cbor = append(cbor, "solc"...)
+	cbor = append(cbor, 0x43, 0x00, 0x08, 0x18)
+
+	runtime := []byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x00}
+	runtime = append(runtime, cbor...)
+	return append(runtime, 0x00, byte(len(cbor)))
+}
+
+func TestParseMetadata(t *testing.T) {
+	metadata, err := ParseMetadata(testRuntime())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if metadata.Solc != "0.8.24" {
+		t.Errorf("Expected solc 0.8.24, got %q", metadata.Solc)
+	}
+	if !strings.HasPrefix(metadata.IPFS, "Qm") || len(metadata.IPFS) != 46 {
+		t.Errorf("Expected a CIDv0 IPFS hash, got %q", metadata.IPFS)
+	}
+
+	if _, err := ParseMetadata([]byte{0x60, 0x80, 0x00, 0x02}); err != ErrNoMetadata {
+		t.Errorf("Expected ErrNoMetadata, got %v", err)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	runtime := testRuntime()
+	init := []byte{0x60, byte(len(runtime)), 0x80, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3, 0xfe}
+	args, _ := hex.DecodeString(strings.Repeat("0", 62) + "2a")
+
+	creation := append(append(append([]byte{}, init...), runtime...), args...)
+
+	analysis, err := Analyze(creation, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.Equal(analysis.InitCode, init) {
+		t.Errorf("Unexpected init code: %x", analysis.InitCode)
+	}
+	if !bytes.Equal(analysis.RuntimeCode, runtime) {
+		t.Errorf("Unexpected runtime code: %x", analysis.RuntimeCode)
+	}
+	if !bytes.Equal(analysis.ConstructorArgs, args) {
+		t.Errorf("Unexpected constructor args: %x", analysis.ConstructorArgs)
+	}
+	if analysis.Metadata == nil || analysis.Metadata.Solc != "0.8.24" {
+		t.Errorf("Unexpected metadata: %+v", analysis.Metadata)
+	}
+
+	analysis, err = Analyze(creation, runtime)
+	if err != nil || !bytes.Equal(analysis.ConstructorArgs, args) {
+		t.Errorf("Expected split using known runtime, got %+v, %v", analysis, err)
+	}
+
+	if _, err := Analyze([]byte{0x60, 0x00, 0x00}, nil); err != ErrRuntimeNotFound {
+		t.Errorf("Expected ErrRuntimeNotFound, got %v", err)
+	}
+}
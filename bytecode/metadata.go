@@ -0,0 +1,231 @@
+package bytecode
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrNoMetadata = errors.New("no CBOR metadata found")
+
+// Metadata is the CBOR trailer solc appends to runtime bytecode.
+type Metadata struct {
+	Raw          []byte
+	Solc         string
+	IPFS         string
+	Bzzr0        string
+	Bzzr1        string
+	Experimental bool
+}
+
+// ParseMetadata decodes the metadata trailer at the very end of code, as
+// found in deployed runtime bytecode: the CBOR map followed by its length as
+// a two-byte big-endian integer.
+func ParseMetadata(code []byte) (*Metadata, error) {
+	if len(code) < 2 {
+		return nil, ErrNoMetadata
+	}
+	n := int(binary.BigEndian.Uint16(code[len(code)-2:]))
+	start := len(code) - 2 - n
+	if n == 0 || start < 0 {
+		return nil, ErrNoMetadata
+	}
+
+	metadata, size, err := decodeMetadata(code[start : len(code)-2])
+	if err != nil || size != n {
+		return nil, ErrNoMetadata
+	}
+	return metadata, nil
+}
+
+// findMetadata returns the offset just past the last metadata trailer found
+// anywhere in code, which is where the runtime code ends inside creation code.
+func findMetadata(code []byte) (*Metadata, int, bool) {
+	for i := len(code) - 3; i >= 0; i-- {
+		if code[i] < 0xa1 || code[i] > 0xa5 || !looksLikeMetadataKey(code[i+1:]) {
+			continue
+		}
+		metadata, size, err := decodeMetadata(code[i:])
+		if err != nil || i+size+2 > len(code) {
+			continue
+		}
+		if int(binary.BigEndian.Uint16(code[i+size:])) != size {
+			continue
+		}
+		return metadata, i + size + 2, true
+	}
+	return nil, 0, false
+}
+
+func looksLikeMetadataKey(b []byte) bool {
+	for _, key := range []string{"ipfs", "bzzr0", "bzzr1", "solc", "experimental"} {
+		if len(b) > len(key) && int(b[0]) == 0x60+len(key) && string(b[1:1+len(key)]) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeMetadata(data []byte) (*Metadata, int, error) {
+	d := &cborDecoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return nil, 0, err
+	}
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return nil, 0, fmt.Errorf("metadata is not a CBOR map")
+	}
+
+	metadata := &Metadata{Raw: append([]byte(nil), data[:d.pos]...)}
+	for key, v := range fields {
+		switch key {
+		case "solc":
+			switch s := v.(type) {
+			case []byte:
+				if len(s) == 3 {
+					metadata.Solc = fmt.Sprintf("%d.%d.%d", s[0], s[1], s[2])
+				}
+			case string:
+				metadata.Solc = s
+			}
+		case "ipfs":
+			if b, ok := v.([]byte); ok {
+				metadata.IPFS = base58Encode(b)
+			}
+		case "bzzr0":
+			if b, ok := v.([]byte); ok {
+				metadata.Bzzr0 = hex.EncodeToString(b)
+			}
+		case "bzzr1":
+			if b, ok := v.([]byte); ok {
+				metadata.Bzzr1 = hex.EncodeToString(b)
+			}
+		case "experimental":
+			metadata.Experimental, _ = v.(bool)
+		}
+	}
+	return metadata, d.pos, nil
+}
+
+// cborDecoder understands the subset of CBOR used by solc metadata.
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *cborDecoder) decode() (any, error) {
+	if d.pos >= len(d.data) {
+		return nil, fmt.Errorf("unexpected end of CBOR data")
+	}
+	initial := d.data[d.pos]
+	d.pos++
+	major, info := initial>>5, initial&0x1f
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("unsupported CBOR simple value %d", info)
+		}
+	}
+
+	n, err := d.length(info)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case 0:
+		return n, nil
+	case 1:
+		return -1 - int64(n), nil
+	case 2, 3:
+		if uint64(len(d.data)-d.pos) < n {
+			return nil, fmt.Errorf("CBOR string exceeds data")
+		}
+		b := d.data[d.pos : d.pos+int(n)]
+		d.pos += int(n)
+		if major == 3 {
+			return string(b), nil
+		}
+		return append([]byte(nil), b...), nil
+	case 4:
+		items := make([]any, 0)
+		for i := uint64(0); i < n; i++ {
+			item, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case 5:
+		m := map[string]any{}
+		for i := uint64(0); i < n; i++ {
+			key, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported CBOR map key %v", key)
+			}
+			if m[k], err = d.decode(); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported CBOR major type %d", major)
+	}
+}
+
+func (d *cborDecoder) length(info byte) (uint64, error) {
+	if info < 24 {
+		return uint64(info), nil
+	}
+	size := map[byte]int{24: 1, 25: 2, 26: 4, 27: 8}[info]
+	if size == 0 {
+		return 0, fmt.Errorf("unsupported CBOR length encoding %d", info)
+	}
+	if d.pos+size > len(d.data) {
+		return 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+	var n uint64
+	for _, b := range d.data[d.pos : d.pos+size] {
+		n = n<<8 | uint64(b)
+	}
+	d.pos += size
+	return n, nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Encode(b []byte) string {
+	x := new(big.Int).SetBytes(b)
+	base, mod := big.NewInt(58), new(big.Int)
+
+	var out []byte
+	for x.Sign() > 0 {
+		x.DivMod(x, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
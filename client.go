@@ -0,0 +1,325 @@
+package kaiascan
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run ./kaiascantest/internal/genfakes -o kaiascantest/fakes_gen.go
+
+// API is the whole OAPI surface, grouped by domain so callers can depend on
+// only the part they use.
+type API interface {
+	BlocksAPI
+	TransactionsAPI
+	AccountsAPI
+	TokensAPI
+	NftsAPI
+	ContractsAPI
+}
+
+// BlocksAPI covers blocks and their burns, rewards and transactions.
+type BlocksAPI interface {
+	GetLatestBlock() (*ApiResponse[Block], error)
+	GetBlock(blockNumber int64) (*ApiResponse[Block], error)
+	GetBlocks(blockNumber int, blockNumberStart *int, blockNumberEnd *int, page int, size int) (*ApiResponse[any], error)
+	GetBlocksByTimestamp(timestamp int64) (*ApiResponse[[]Block], error)
+	GetLatestBlockBurns(page int, size int) (*ApiResponse[any], error)
+	GetLatestBlockRewards(blockNumber int) (*ApiResponse[any], error)
+	GetBlockBurns(blockNumber int) (*ApiResponse[any], error)
+	GetBlockRewards(blockNumber int) (*ApiResponse[any], error)
+	GetTransactionsOfBlock(blockNumber int, transactionType *string, page int, size int) (*ApiResponse[Page[Transaction]], error)
+	GetInternalTransactionsOfBlock(blockNumber int, page int, size int) (*ApiResponse[any], error)
+}
+
+type TransactionsAPI interface {
+	GetTransaction(transactionHash string) (*ApiResponse[Transaction], error)
+	GetTransactionStatus(transactionHash string) (*ApiResponse[any], error)
+	GetTransactionReceiptStatus(transactionHash string) (*ApiResponse[any], error)
+	GetTransactionInputData(transactionHash string) (*ApiResponse[any], error)
+	GetTransactionEventLogs(transactionHash string, page int, size int, signature *string) (*ApiResponse[Page[EventLog]], error)
+	GetTransactionInternalTransactions(transactionHash string, page int, size int) (*ApiResponse[any], error)
+	GetTransactionTokenTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[TokenTransfer]], error)
+	GetTransactionNftTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[NftTransfer]], error)
+}
+
+type AccountsAPI interface {
+	GetAccountInfo(accountAddress string) (*ApiResponse[AccountInfo], error)
+	GetAccountKeyHistories(accountAddress string, page int, size int) (*ApiResponse[any], error)
+	GetAccountTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*ApiResponse[Page[Transaction]], error)
+	GetFeePaidTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*ApiResponse[Page[Transaction]], error)
+	GetAccountTokenBalances(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error)
+	GetAccountTokenDetails(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error)
+	GetAccountTokenTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error)
+	GetAccountNftTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[NftTransfer]], error)
+	GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error)
+	GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error)
+	GetAccountEventLogs(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[EventLog]], error)
+}
+
+type TokensAPI interface {
+	GetFungibleToken(tokenAddress Address) (*ApiResponse[TokenInfo], error)
+	GetTokenHolders(tokenAddress string, page int, size int, holderAddress *string) (*ApiResponse[Page[TokenHolder]], error)
+	GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error)
+	GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenBurn]], error)
+}
+
+type NftsAPI interface {
+	GetNftInfo(tokenAddress string) (*ApiResponse[NftCollection], error)
+	GetNftItem(nftAddress Address, tokenId string) (*ApiResponse[NftItem], error)
+	GetNftHolders(tokenAddress string, page int, size int, tokenId *string) (*ApiResponse[Page[NftHolder]], error)
+	GetNftTransfers(tokenAddress string, page int, size int, tokenId *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[NftTransfer]], error)
+	GetNftInventories(tokenAddress string, page int, size int, keyword *string) (*ApiResponse[Page[NftInventoryEntry]], error)
+}
+
+type ContractsAPI interface {
+	GetContractInfo(contractAddress string) (*ApiResponse[ContractInfo], error)
+	GetContractsInfo(contractAddresses []string) (*ApiResponse[[]ContractInfo], error)
+	GetContractAbi(contractAddress string) (*ApiResponse[ContractAbi], error)
+	GetContractSourceCode(contractAddress Address) (*ApiResponse[ContractSourceCode], error)
+	GetContractCreationCode(contractAddress Address) (*ApiResponse[ContractCreationCode], error)
+}
+
+// Client implements API with the package-level functions, so it follows
+// ConfigureSDK and ConfigureTransport. The higher-level helpers use a Client
+// unless given another API: helpers that take options have an API field,
+// BlockWatcher and TokenMetadataCache have one too, and the rest are
+// available as methods on Helpers.
+type Client struct{}
+
+var _ API = (*Client)(nil)
+
+func NewClient() *Client {
+	return &Client{}
+}
+
+func (c *Client) GetLatestBlock() (*ApiResponse[Block], error) {
+	return GetLatestBlock()
+}
+
+func (c *Client) GetBlock(blockNumber int64) (*ApiResponse[Block], error) {
+	return GetBlock(blockNumber)
+}
+
+func (c *Client) GetBlocks(blockNumber int, blockNumberStart *int, blockNumberEnd *int, page int, size int) (*ApiResponse[any], error) {
+	return GetBlocks(blockNumber, blockNumberStart, blockNumberEnd, page, size)
+}
+
+func (c *Client) GetBlocksByTimestamp(timestamp int64) (*ApiResponse[[]Block], error) {
+	return GetBlocksByTimestamp(timestamp)
+}
+
+func (c *Client) GetLatestBlockBurns(page int, size int) (*ApiResponse[any], error) {
+	return GetLatestBlockBurns(page, size)
+}
+
+func (c *Client) GetLatestBlockRewards(blockNumber int) (*ApiResponse[any], error) {
+	return GetLatestBlockRewards(blockNumber)
+}
+
+func (c *Client) GetBlockBurns(blockNumber int) (*ApiResponse[any], error) {
+	return GetBlockBurns(blockNumber)
+}
+
+func (c *Client) GetBlockRewards(blockNumber int) (*ApiResponse[any], error) {
+	return GetBlockRewards(blockNumber)
+}
+
+func (c *Client) GetTransactionsOfBlock(blockNumber int, transactionType *string, page int, size int) (*ApiResponse[Page[Transaction]], error) {
+	return GetTransactionsOfBlock(blockNumber, transactionType, page, size)
+}
+
+func (c *Client) GetInternalTransactionsOfBlock(blockNumber int, page int, size int) (*ApiResponse[any], error) {
+	return GetInternalTransactionsOfBlock(blockNumber, page, size)
+}
+
+func (c *Client) GetTransaction(transactionHash string) (*ApiResponse[Transaction], error) {
+	return GetTransaction(transactionHash)
+}
+
+func (c *Client) GetTransactionStatus(transactionHash string) (*ApiResponse[any], error) {
+	return GetTransactionStatus(transactionHash)
+}
+
+func (c *Client) GetTransactionReceiptStatus(transactionHash string) (*ApiResponse[any], error) {
+	return GetTransactionReceiptStatus(transactionHash)
+}
+
+func (c *Client) GetTransactionInputData(transactionHash string) (*ApiResponse[any], error) {
+	return GetTransactionInputData(transactionHash)
+}
+
+func (c *Client) GetTransactionEventLogs(transactionHash string, page int, size int, signature *string) (*ApiResponse[Page[EventLog]], error) {
+	return GetTransactionEventLogs(transactionHash, page, size, signature)
+}
+
+func (c *Client) GetTransactionInternalTransactions(transactionHash string, page int, size int) (*ApiResponse[any], error) {
+	return GetTransactionInternalTransactions(transactionHash, page, size)
+}
+
+func (c *Client) GetTransactionTokenTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[TokenTransfer]], error) {
+	return GetTransactionTokenTransfers(transactionHash, page, size)
+}
+
+func (c *Client) GetTransactionNftTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[NftTransfer]], error) {
+	return GetTransactionNftTransfers(transactionHash, page, size)
+}
+
+func (c *Client) GetAccountInfo(accountAddress string) (*ApiResponse[AccountInfo], error) {
+	return GetAccountInfo(accountAddress)
+}
+
+func (c *Client) GetAccountKeyHistories(accountAddress string, page int, size int) (*ApiResponse[any], error) {
+	return GetAccountKeyHistories(accountAddress, page, size)
+}
+
+func (c *Client) GetAccountTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*ApiResponse[Page[Transaction]], error) {
+	return GetAccountTransactions(accountAddress, page, size, blockNumberStart, blockNumberEnd, txType, directions)
+}
+
+func (c *Client) GetFeePaidTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*ApiResponse[Page[Transaction]], error) {
+	return GetFeePaidTransactions(accountAddress, page, size, blockNumberStart, blockNumberEnd, txType)
+}
+
+func (c *Client) GetAccountTokenBalances(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
+	return GetAccountTokenBalances(accountAddress, page, size)
+}
+
+func (c *Client) GetAccountTokenDetails(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
+	return GetAccountTokenDetails(accountAddress, page, size)
+}
+
+func (c *Client) GetAccountTokenTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error) {
+	return GetAccountTokenTransfers(accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetAccountNftTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[NftTransfer]], error) {
+	return GetAccountNftTransfers(accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error) {
+	return GetAccountKIP17NftBalances(accountAddress, page, size)
+}
+
+func (c *Client) GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error) {
+	return GetAccountKIP37NftBalances(accountAddress, page, size)
+}
+
+func (c *Client) GetAccountEventLogs(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[EventLog]], error) {
+	return GetAccountEventLogs(accountAddress, page, size, signature, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetFungibleToken(tokenAddress Address) (*ApiResponse[TokenInfo], error) {
+	return GetFungibleToken(tokenAddress)
+}
+
+func (c *Client) GetTokenHolders(tokenAddress string, page int, size int, holderAddress *string) (*ApiResponse[Page[TokenHolder]], error) {
+	return GetTokenHolders(tokenAddress, page, size, holderAddress)
+}
+
+func (c *Client) GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error) {
+	return GetTokenTransfers(tokenAddress, page, size, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenBurn]], error) {
+	return GetTokenBurns(tokenAddress, page, size, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetNftInfo(tokenAddress string) (*ApiResponse[NftCollection], error) {
+	return GetNftInfo(tokenAddress)
+}
+
+func (c *Client) GetNftItem(nftAddress Address, tokenId string) (*ApiResponse[NftItem], error) {
+	return GetNftItem(nftAddress, tokenId)
+}
+
+func (c *Client) GetNftHolders(tokenAddress string, page int, size int, tokenId *string) (*ApiResponse[Page[NftHolder]], error) {
+	return GetNftHolders(tokenAddress, page, size, tokenId)
+}
+
+func (c *Client) GetNftTransfers(tokenAddress string, page int, size int, tokenId *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[NftTransfer]], error) {
+	return GetNftTransfers(tokenAddress, page, size, tokenId, blockNumberStart, blockNumberEnd)
+}
+
+func (c *Client) GetNftInventories(tokenAddress string, page int, size int, keyword *string) (*ApiResponse[Page[NftInventoryEntry]], error) {
+	return GetNftInventories(tokenAddress, page, size, keyword)
+}
+
+func (c *Client) GetContractInfo(contractAddress string) (*ApiResponse[ContractInfo], error) {
+	return GetContractInfo(contractAddress)
+}
+
+func (c *Client) GetContractsInfo(contractAddresses []string) (*ApiResponse[[]ContractInfo], error) {
+	return GetContractsInfo(contractAddresses)
+}
+
+func (c *Client) GetContractAbi(contractAddress string) (*ApiResponse[ContractAbi], error) {
+	return GetContractAbi(contractAddress)
+}
+
+func (c *Client) GetContractSourceCode(contractAddress Address) (*ApiResponse[ContractSourceCode], error) {
+	return GetContractSourceCode(contractAddress)
+}
+
+func (c *Client) GetContractCreationCode(contractAddress Address) (*ApiResponse[ContractCreationCode], error) {
+	return GetContractCreationCode(contractAddress)
+}
+
+// Helpers runs the higher-level helpers that take no options against an API
+// instead of a Client, so that they can be driven by a fake.
+type Helpers struct {
+	api API
+}
+
+func NewHelpers(api API) *Helpers {
+	return &Helpers{api: api}
+}
+
+func (h *Helpers) BlockAtOrAfter(ctx context.Context, t time.Time) (*Block, error) {
+	return blockAtOrAfter(ctx, h.api, t)
+}
+
+func (h *Helpers) BlockAtOrBefore(ctx context.Context, t time.Time) (*Block, error) {
+	return blockAtOrBefore(ctx, h.api, t)
+}
+
+func (h *Helpers) BlockRangeForInterval(ctx context.Context, start time.Time, end time.Time) (*BlockRange, error) {
+	return blockRangeForInterval(ctx, h.api, start, end)
+}
+
+func (h *Helpers) GetPortfolio(ctx context.Context, accountAddress string) (*Portfolio, error) {
+	return getPortfolio(ctx, h.api, accountAddress)
+}
+
+func (h *Helpers) SnapshotNftCollection(ctx context.Context, tokenAddress string, blockNumberEnd *int) (*NftSnapshot, error) {
+	return snapshotNftCollection(ctx, h.api, tokenAddress, blockNumberEnd)
+}
+
+func (h *Helpers) SnapshotTokenHolders(ctx context.Context, tokenAddress string, blockNumber int) (*TokenHolderSnapshot, error) {
+	return snapshotTokenHolders(ctx, h.api, tokenAddress, blockNumber)
+}
+
+func (h *Helpers) ResolveImplementation(ctx context.Context, contractAddress string) (*ProxyResolution, error) {
+	return resolveImplementation(ctx, h.api, contractAddress)
+}
+
+func (h *Helpers) GetMergedAbi(ctx context.Context, contractAddress string) (Abi, error) {
+	return getMergedAbi(ctx, h.api, contractAddress)
+}
+
+func (h *Helpers) AnalyzeContractBytecode(contractAddress string) (*ContractBytecode, error) {
+	return analyzeContractBytecode(h.api, contractAddress)
+}
+
+func (h *Helpers) ExportContractSource(contractAddress string, dir string) (*SourceExportMetadata, error) {
+	return exportContractSource(h.api, contractAddress, dir)
+}
+
+// apiOrDefault returns api, or a Client when api is nil, for helpers that
+// take an optional API.
+func apiOrDefault[T any](api T) T {
+	if any(api) == nil {
+		return any(NewClient()).(T)
+	}
+	return api
+}
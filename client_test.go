@@ -0,0 +1,35 @@
+package kaiascan
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(Block{BlockNumber: 42}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/accounts/0xabc"):
+			w.Write(mockApiResponse(AccountInfo{Address: "0xabc", AccountType: "EOA"}, 0, "Success"))
+		default:
+			t.Errorf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	var api API = NewClient()
+	latest, err := api.GetLatestBlock()
+	if err != nil || latest.Data.BlockNumber != 42 {
+		t.Errorf("Unexpected latest block: %+v, %v", latest, err)
+	}
+
+	var accounts AccountsAPI = api
+	info, err := accounts.GetAccountInfo("0xabc")
+	if err != nil || info.Data.AccountType != "EOA" {
+		t.Errorf("Unexpected account: %+v, %v", info, err)
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+
+	kaiascan "kaiascan.go"
+	"kaiascan.go/output"
+)
+
+var accountCommands = []*subcommand{
+	{
+		name:  "info",
+		args:  "<address>",
+		help:  "show an account",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountInfo(inv.args[0]))
+		},
+	},
+	{
+		name:  "txs",
+		args:  "<address>",
+		help:  "list the transactions of an account",
+		nargs: 1,
+		flags: []string{"from", "to", "type", "direction", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountTransactions(inv.args[0], inv.page, inv.size, inv.from, inv.to, optional(inv.txType), split(inv.direction)))
+		},
+	},
+	{
+		name:  "fee-paid",
+		args:  "<address>",
+		help:  "list the transactions whose fee the account paid",
+		nargs: 1,
+		flags: []string{"from", "to", "type", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetFeePaidTransactions(inv.args[0], inv.page, inv.size, inv.from, inv.to, optional(inv.txType)))
+		},
+	},
+	{
+		name:  "token-balances",
+		args:  "<address>",
+		help:  "list the token balances of an account",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountTokenBalances(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "token-details",
+		args:  "<address>",
+		help:  "list the token balances of an account with token details",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountTokenDetails(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "token-transfers",
+		args:  "<address>",
+		help:  "list the token transfers of an account",
+		nargs: 1,
+		flags: []string{"contract", "from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountTokenTransfers(inv.args[0], inv.page, inv.size, optional(inv.contract), inv.from, inv.to))
+		},
+	},
+	{
+		name:  "nft-transfers",
+		args:  "<address>",
+		help:  "list the NFT transfers of an account",
+		nargs: 1,
+		flags: []string{"contract", "from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountNftTransfers(inv.args[0], inv.page, inv.size, optional(inv.contract), inv.from, inv.to))
+		},
+	},
+	{
+		name:  "kip17",
+		args:  "<address>",
+		help:  "list the KIP-17 NFT balances of an account",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountKIP17NftBalances(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "kip37",
+		args:  "<address>",
+		help:  "list the KIP-37 NFT balances of an account",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountKIP37NftBalances(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "logs",
+		args:  "<address>",
+		help:  "list the event logs emitted by an account",
+		nargs: 1,
+		flags: []string{"signature", "from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountEventLogs(inv.args[0], inv.page, inv.size, optional(inv.signature), inv.from, inv.to))
+		},
+	},
+	{
+		name:  "key-histories",
+		args:  "<address>",
+		help:  "list the account key changes of an account",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetAccountKeyHistories(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "export",
+		args:  "<address>",
+		help:  "stream the full transaction, token and NFT transfer history to files in -out, resuming an interrupted export",
+		nargs: 1,
+		flags: []string{"from", "to", "format", "out"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			format, err := output.ParseFormat(inv.format)
+			if err != nil {
+				return nil, err
+			}
+			dir := inv.out
+			if dir == "" {
+				dir = inv.args[0]
+			}
+			return kaiascan.ExportAccountHistory(ctx, inv.args[0], dir, kaiascan.AccountExportOptions{
+				Format:           format,
+				BlockNumberStart: inv.from,
+				BlockNumberEnd:   inv.to,
+			})
+		},
+	},
+	{
+		name:  "ledger",
+		args:  "<address>",
+		help:  "write a tax-tool CSV ledger of transfers, mints, burns, swaps and fees",
+		nargs: 1,
+		flags: []string{"from", "to"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			ledger, err := kaiascan.BuildLedger(ctx, inv.args[0], kaiascan.LedgerOptions{
+				BlockNumberStart: inv.from,
+				BlockNumberEnd:   inv.to,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, ledger.WriteCSV(inv.stdout)
+		},
+	},
+	{
+		name:  "fee-report",
+		args:  "<address>",
+		help:  "aggregate the fees a fee payer covered per sender, day and type, flagging unusual spenders",
+		nargs: 1,
+		flags: []string{"from", "to", "since", "until"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.AnalyzeFeeDelegation(ctx, inv.args[0], kaiascan.FeeDelegationOptions{
+				BlockNumberStart: inv.from,
+				BlockNumberEnd:   inv.to,
+				Since:            inv.since,
+				Until:            inv.until,
+			})
+		},
+	},
+	{
+		name:  "portfolio",
+		args:  "<address>",
+		help:  "show the native, token and NFT holdings of an account",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.GetPortfolio(ctx, inv.args[0])
+		},
+	},
+}
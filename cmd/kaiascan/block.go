@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	kaiascan "kaiascan.go"
+)
+
+var blockCommands = []*subcommand{
+	{
+		name: "latest",
+		help: "show the latest block",
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetLatestBlock())
+		},
+	},
+	{
+		name:  "get",
+		args:  "<number>",
+		help:  "show a block",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetBlock(int64(n)))
+		},
+	},
+	{
+		name:  "list",
+		args:  "<number>",
+		help:  "list blocks, optionally within -from/-to",
+		nargs: 1,
+		flags: []string{"from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetBlocks(n, inv.from, inv.to, inv.page, inv.size))
+		},
+	},
+	{
+		name:  "txs",
+		args:  "<number>",
+		help:  "list the transactions of a block",
+		nargs: 1,
+		flags: []string{"type", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetTransactionsOfBlock(n, optional(inv.txType), inv.page, inv.size))
+		},
+	},
+	{
+		name:  "internal-txs",
+		args:  "<number>",
+		help:  "list the internal transactions of a block",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetInternalTransactionsOfBlock(n, inv.page, inv.size))
+		},
+	},
+	{
+		name:  "burns",
+		args:  "<number>",
+		help:  "show the KAIA burnt in a block",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetBlockBurns(n))
+		},
+	},
+	{
+		name:  "rewards",
+		args:  "<number>",
+		help:  "show the rewards paid in a block",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			n, err := argInt(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return result(kaiascan.GetBlockRewards(n))
+		},
+	},
+	{
+		name:  "at",
+		args:  "<time>",
+		help:  "find the first block at or after a time (RFC 3339 or unix seconds)",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			t, err := parseTime(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return kaiascan.BlockAtOrAfter(ctx, t)
+		},
+	},
+	{
+		name:  "before",
+		args:  "<time>",
+		help:  "find the last block at or before a time (RFC 3339 or unix seconds)",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			t, err := parseTime(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			return kaiascan.BlockAtOrBefore(ctx, t)
+		},
+	},
+	{
+		name:  "range",
+		args:  "<start> <end>",
+		help:  "find the blocks produced in [start, end)",
+		nargs: 2,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			start, err := parseTime(inv.args[0])
+			if err != nil {
+				return nil, err
+			}
+			end, err := parseTime(inv.args[1])
+			if err != nil {
+				return nil, err
+			}
+			return kaiascan.BlockRangeForInterval(ctx, start, end)
+		},
+	},
+}
+
+func parseTime(s string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC 3339 or unix seconds", s)
+	}
+	return t, nil
+}
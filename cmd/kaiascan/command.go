@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	kaiascan "kaiascan.go"
+	"kaiascan.go/output"
+)
+
+// subcommand is a single CLI operation. flags lists the optional flags it
+// accepts beyond -network and the output flags; run returns the value to
+// print. Subcommands that take -format write files in that format and print
+// their result as JSON, so they do not take the output flags.
+type subcommand struct {
+	name  string
+	args  string
+	help  string
+	nargs int
+	flags []string
+	run   func(ctx context.Context, inv *invocation) (any, error)
+}
+
+type invocation struct {
+	args      []string
+	stdout    io.Writer
+	page      int
+	size      int
+	from      *int
+	to        *int
+	block     *int
+	since     time.Time
+	until     time.Time
+	txType    string
+	contract  string
+	tokenId   string
+	keyword   string
+	signature string
+	direction string
+	format    string
+	out       string
+}
+
+func (c *subcommand) execute(ctx context.Context, group string, args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet(group+" "+c.name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	inv := &invocation{stdout: stdout}
+	network := fs.String("network", "mainnet", "mainnet, kairos or an OAPI base URL")
+	format, columns, width := "json", "", 0
+	if !slices.Contains(c.flags, "format") {
+		fs.StringVar(&format, "output", "json", "output format: json, jsonl, csv or table")
+		fs.StringVar(&columns, "columns", "", "comma-separated columns to show in csv and table output")
+		fs.IntVar(&width, "width", 0, "truncate table cells to this many characters")
+	}
+	for _, name := range c.flags {
+		switch name {
+		case "page":
+			fs.IntVar(&inv.page, "page", 1, "page number, starting at 1")
+		case "size":
+			fs.IntVar(&inv.size, "size", 20, "page size, up to 2000")
+		case "from":
+			fs.Func("from", "first block number of the range", intFlag(&inv.from))
+		case "to":
+			fs.Func("to", "last block number of the range", intFlag(&inv.to))
+		case "since":
+			fs.Func("since", "start of the time window (RFC 3339 or unix seconds)", timeFlag(&inv.since))
+		case "until":
+			fs.Func("until", "end of the time window, exclusive (RFC 3339 or unix seconds)", timeFlag(&inv.until))
+		case "block":
+			fs.Func("block", "block number", intFlag(&inv.block))
+		case "type":
+			fs.StringVar(&inv.txType, "type", "", "transaction type filter")
+		case "contract":
+			fs.StringVar(&inv.contract, "contract", "", "contract address filter")
+		case "token-id":
+			fs.StringVar(&inv.tokenId, "token-id", "", "token ID filter")
+		case "keyword":
+			fs.StringVar(&inv.keyword, "keyword", "", "search keyword")
+		case "signature":
+			fs.StringVar(&inv.signature, "signature", "", "event signature filter")
+		case "direction":
+			fs.StringVar(&inv.direction, "direction", "", "comma-separated directions: from, to")
+		case "format":
+			fs.StringVar(&inv.format, "format", "csv", "file format: csv or jsonl")
+		case "out":
+			fs.StringVar(&inv.out, "out", "", "output directory")
+		default:
+			panic("unknown flag " + name)
+		}
+	}
+
+	synopsis := fmt.Sprintf("usage: kaiascan %s %s [flags] %s", group, c.name, c.args)
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(stdout, "%s\n\n%s\n\nflags:\n", synopsis, c.help)
+			fs.SetOutput(stdout)
+			fs.PrintDefaults()
+			return nil
+		}
+		return fmt.Errorf("%w\n%s", err, synopsis)
+	}
+	if len(positional) != c.nargs {
+		return errors.New(synopsis)
+	}
+	f, err := output.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+	if err := configureNetwork(*network); err != nil {
+		return err
+	}
+	inv.args = positional
+
+	result, err := c.run(ctx, inv)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return output.Render(stdout, f, result, output.Options{Columns: split(columns), MaxWidth: width})
+}
+
+// parseInterspersed parses flags given before, between or after the
+// positional arguments, which a FlagSet alone stops at, and returns the
+// positional arguments. Everything after "--" is positional.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func intFlag(target **int) func(string) error {
+	return func(s string) error {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		*target = &v
+		return nil
+	}
+}
+
+func timeFlag(target *time.Time) func(string) error {
+	return func(s string) error {
+		t, err := parseTime(s)
+		if err != nil {
+			return err
+		}
+		*target = t
+		return nil
+	}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func argInt(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+// result unwraps an API response so only its payload is printed.
+func result[T any](resp *kaiascan.ApiResponse[T], err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	kaiascan "kaiascan.go"
+)
+
+var contractCommands = []*subcommand{
+	{
+		name:  "info",
+		args:  "<address>",
+		help:  "show a contract",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetContractInfo(inv.args[0]))
+		},
+	},
+	{
+		name:  "source",
+		args:  "<address>",
+		help:  "show the verified source code of a contract",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetContractSourceCode(inv.args[0]))
+		},
+	},
+	{
+		name:  "abi",
+		args:  "<address>",
+		help:  "show the ABI of a contract, merged with its implementation for proxies",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.GetMergedAbi(ctx, inv.args[0])
+		},
+	},
+	{
+		name:  "creation-code",
+		args:  "<address>",
+		help:  "show the creation bytecode of a contract",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetContractCreationCode(inv.args[0]))
+		},
+	},
+	{
+		name:  "bytecode",
+		args:  "<address>",
+		help:  "split the creation bytecode and decode constructor arguments and metadata",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.AnalyzeContractBytecode(inv.args[0])
+		},
+	},
+	{
+		name:  "proxy",
+		args:  "<address>",
+		help:  "resolve the implementation behind a proxy",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.ResolveImplementation(ctx, inv.args[0])
+		},
+	},
+	{
+		name:  "export",
+		args:  "<address>",
+		help:  "write verified contract sources to -out (default: the contract address)",
+		nargs: 1,
+		flags: []string{"out"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			address := inv.args[0]
+			dir := inv.out
+			if dir == "" {
+				dir = address
+			}
+
+			metadata, err := kaiascan.ExportContractSource(address, dir)
+			if err != nil {
+				return nil, err
+			}
+
+			fmt.Fprintf(inv.stdout, "exported %s (%s, %d files) to %s\n", metadata.ContractName, metadata.SourceFormat, len(metadata.Files), dir)
+			return nil, nil
+		},
+	},
+}
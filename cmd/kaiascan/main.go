@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	kaiascan "kaiascan.go"
+)
+
+var groups = map[string][]*subcommand{
+	"block":    blockCommands,
+	"tx":       txCommands,
+	"account":  accountCommands,
+	"token":    tokenCommands,
+	"nft":      nftCommands,
+	"contract": contractCommands,
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "kaiascan:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command\n%s", usage())
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		fmt.Fprintln(stdout, usage())
+		return nil
+	}
+
+	commands, ok := groups[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage())
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("missing %s subcommand\n%s", args[0], groupUsage(args[0]))
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[1] {
+			return cmd.execute(ctx, args[0], args[2:], stdout)
+		}
+	}
+	return fmt.Errorf("unknown %s subcommand %q\n%s", args[0], args[1], groupUsage(args[0]))
+}
+
+func usage() string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("usage: kaiascan <command> <subcommand> [flags] [args]\n")
+	for _, name := range names {
+		b.WriteString("\n" + groupUsage(name))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func groupUsage(group string) string {
+	var b strings.Builder
+	for _, cmd := range groups[group] {
+		fmt.Fprintf(&b, "  %s %s %s\n      %s\n", group, cmd.name, cmd.args, cmd.help)
+	}
+	return b.String()
+}
+
+// configureNetwork accepts mainnet, kairos (or testnet), or a base URL for a
+// self-hosted or proxied OAPI.
+func configureNetwork(network string) error {
+	switch strings.ToLower(network) {
+	case "mainnet", "":
+		kaiascan.ConfigureSDK(false)
+	case "kairos", "testnet":
+		kaiascan.ConfigureSDK(true)
+	default:
+		if !strings.HasPrefix(network, "http://") && !strings.HasPrefix(network, "https://") {
+			return fmt.Errorf("unknown network %q, expected mainnet, kairos or a URL", network)
+		}
+		kaiascan.BASE_URL = strings.TrimRight(network, "/") + "/"
+	}
+	return nil
+}
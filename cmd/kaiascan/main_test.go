@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kaiascan "kaiascan.go"
+)
+
+func TestRun_AccountTxs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/accounts/0xabc/transactions") {
+			t.Errorf("Unexpected API path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("page") != "2" || q.Get("size") != "5" || q.Get("blockNumberStart") != "100" || q.Get("blockNumberEnd") != "200" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"code":0,"msg":"Success","data":{"paging":{"last":true},"results":[{"transactionHash":"0xtx","blockId":150}]}}`))
+	}))
+	defer server.Close()
+	defer kaiascan.ConfigureSDK(false)
+
+	for _, args := range [][]string{
+		{"account", "txs", "-network", server.URL, "-page", "2", "-size", "5", "-from", "100", "-to", "200", "0xabc"},
+		{"account", "txs", "-network", server.URL, "-page", "2", "0xabc", "-size", "5", "-from", "100", "-to", "200"},
+	} {
+		var out bytes.Buffer
+		if err := run(context.Background(), args, &out); err != nil {
+			t.Fatalf("Expected no error for %q, got %v", args, err)
+		}
+		if !strings.Contains(out.String(), `"transactionHash": "0xtx"`) {
+			t.Errorf("Unexpected output: %s", out.String())
+		}
+	}
+}
+
+func TestRun_CSVOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":0,"msg":"Success","data":{"paging":{"last":true},"results":[{"holderAddress":"0xa","amount":"100"},{"holderAddress":"0xb","amount":"5"}]}}`))
+	}))
+	defer server.Close()
+	defer kaiascan.ConfigureSDK(false)
+
+	var out bytes.Buffer
+	err := run(context.Background(), []string{"token", "holders", "-network", server.URL, "-output", "csv", "-columns", "amount,holderAddress", "0xtoken"}, &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if out.String() != "amount,holderAddress\n100,0xa\n5,0xb\n" {
+		t.Errorf("Unexpected output: %q", out.String())
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"wallet"},
+		{"block"},
+		{"block", "mine"},
+		{"block", "get"},
+		{"block", "get", "-network", "devnet", "1"},
+		{"account", "info", "-page", "2", "0xabc"},
+		{"account", "info", "-output", "xml", "0xabc"},
+		{"account", "info", "0xabc", "-bogus"},
+		{"account", "info", "0xabc", "--", "-page"},
+		{"account", "export", "-output", "jsonl", "0xabc"},
+		{"token", "snapshot", "-network", "http://127.0.0.1:0", "0xtoken"},
+	}
+	for _, args := range tests {
+		if err := run(context.Background(), args, &bytes.Buffer{}); err == nil {
+			t.Errorf("Expected an error for %q", args)
+		}
+	}
+}
+
+func TestConfigureNetwork(t *testing.T) {
+	defer kaiascan.ConfigureSDK(false)
+
+	if err := configureNetwork("https://oapi.example.com/proxy"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if kaiascan.BASE_URL != "https://oapi.example.com/proxy/" {
+		t.Errorf("Unexpected base URL: %s", kaiascan.BASE_URL)
+	}
+	if err := configureNetwork("kairos"); err != nil || !strings.Contains(kaiascan.BASE_URL, "kairos") {
+		t.Errorf("Unexpected kairos configuration: %s, %v", kaiascan.BASE_URL, err)
+	}
+}
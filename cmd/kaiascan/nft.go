@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+
+	kaiascan "kaiascan.go"
+)
+
+var nftCommands = []*subcommand{
+	{
+		name:  "info",
+		args:  "<address>",
+		help:  "show an NFT collection",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetNftInfo(inv.args[0]))
+		},
+	},
+	{
+		name:  "item",
+		args:  "<address> <token-id>",
+		help:  "show a single NFT",
+		nargs: 2,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetNftItem(inv.args[0], inv.args[1]))
+		},
+	},
+	{
+		name:  "metadata",
+		args:  "<address> <token-id>",
+		help:  "resolve the off-chain metadata of an NFT",
+		nargs: 2,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			resp, err := kaiascan.GetNftItem(inv.args[0], inv.args[1])
+			if err != nil {
+				return nil, err
+			}
+			return kaiascan.NewMetadataResolver().ResolveItem(ctx, &resp.Data)
+		},
+	},
+	{
+		name:  "holders",
+		args:  "<address>",
+		help:  "list the holders of a collection",
+		nargs: 1,
+		flags: []string{"token-id", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetNftHolders(inv.args[0], inv.page, inv.size, optional(inv.tokenId)))
+		},
+	},
+	{
+		name:  "transfers",
+		args:  "<address>",
+		help:  "list the transfers of a collection",
+		nargs: 1,
+		flags: []string{"token-id", "from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetNftTransfers(inv.args[0], inv.page, inv.size, optional(inv.tokenId), inv.from, inv.to))
+		},
+	},
+	{
+		name:  "inventories",
+		args:  "<address>",
+		help:  "list the tokens of a collection",
+		nargs: 1,
+		flags: []string{"keyword", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetNftInventories(inv.args[0], inv.page, inv.size, optional(inv.keyword)))
+		},
+	},
+	{
+		name:  "snapshot",
+		args:  "<address>",
+		help:  "list every holding of a collection, optionally as of -block",
+		nargs: 1,
+		flags: []string{"block"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.SnapshotNftCollection(ctx, inv.args[0], inv.block)
+		},
+	},
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	kaiascan "kaiascan.go"
+)
+
+var tokenCommands = []*subcommand{
+	{
+		name:  "info",
+		args:  "<address>",
+		help:  "show a fungible token",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetFungibleToken(inv.args[0]))
+		},
+	},
+	{
+		name:  "holders",
+		args:  "<address>",
+		help:  "list the holders of a token",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTokenHolders(inv.args[0], inv.page, inv.size, nil))
+		},
+	},
+	{
+		name:  "transfers",
+		args:  "<address>",
+		help:  "list the transfers of a token",
+		nargs: 1,
+		flags: []string{"from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTokenTransfers(inv.args[0], inv.page, inv.size, inv.from, inv.to))
+		},
+	},
+	{
+		name:  "burns",
+		args:  "<address>",
+		help:  "list the burns of a token",
+		nargs: 1,
+		flags: []string{"from", "to", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTokenBurns(inv.args[0], inv.page, inv.size, inv.from, inv.to))
+		},
+	},
+	{
+		name:  "snapshot",
+		args:  "<address>",
+		help:  "reconstruct the holder balances at -block",
+		nargs: 1,
+		flags: []string{"block"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			if inv.block == nil {
+				return nil, fmt.Errorf("-block is required")
+			}
+			return kaiascan.SnapshotTokenHolders(ctx, inv.args[0], *inv.block)
+		},
+	},
+	{
+		name:  "distribution",
+		args:  "<address>",
+		help:  "show holder concentration metrics",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return kaiascan.AnalyzeTokenHolders(ctx, inv.args[0], kaiascan.HolderDistributionOptions{ExcludeBurnAddresses: true})
+		},
+	},
+}
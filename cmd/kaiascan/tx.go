@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+
+	kaiascan "kaiascan.go"
+)
+
+var txCommands = []*subcommand{
+	{
+		name:  "get",
+		args:  "<hash>",
+		help:  "show a transaction",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransaction(inv.args[0]))
+		},
+	},
+	{
+		name:  "status",
+		args:  "<hash>",
+		help:  "show the status of a transaction",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionStatus(inv.args[0]))
+		},
+	},
+	{
+		name:  "receipt-status",
+		args:  "<hash>",
+		help:  "show the receipt status of a transaction",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionReceiptStatus(inv.args[0]))
+		},
+	},
+	{
+		name:  "input",
+		args:  "<hash>",
+		help:  "show the decoded input data of a transaction",
+		nargs: 1,
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionInputData(inv.args[0]))
+		},
+	},
+	{
+		name:  "logs",
+		args:  "<hash>",
+		help:  "list the event logs of a transaction",
+		nargs: 1,
+		flags: []string{"signature", "page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionEventLogs(inv.args[0], inv.page, inv.size, optional(inv.signature)))
+		},
+	},
+	{
+		name:  "internal-txs",
+		args:  "<hash>",
+		help:  "list the internal transactions of a transaction",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionInternalTransactions(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "token-transfers",
+		args:  "<hash>",
+		help:  "list the token transfers of a transaction",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionTokenTransfers(inv.args[0], inv.page, inv.size))
+		},
+	},
+	{
+		name:  "nft-transfers",
+		args:  "<hash>",
+		help:  "list the NFT transfers of a transaction",
+		nargs: 1,
+		flags: []string{"page", "size"},
+		run: func(ctx context.Context, inv *invocation) (any, error) {
+			return result(kaiascan.GetTransactionNftTransfers(inv.args[0], inv.page, inv.size))
+		},
+	},
+}
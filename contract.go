@@ -0,0 +1,187 @@
+package kaiascan
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type AbiParam struct {
+	Name         string     `json:"name"`
+	Type         string     `json:"type"`
+	InternalType string     `json:"internalType,omitempty"`
+	Indexed      bool       `json:"indexed,omitempty"`
+	Components   []AbiParam `json:"components,omitempty"`
+}
+
+// CanonicalType returns the parameter type as used in signatures, expanding
+// tuples into their component types.
+func (p AbiParam) CanonicalType() string {
+	if !strings.HasPrefix(p.Type, "tuple") {
+		return p.Type
+	}
+	types := make([]string, len(p.Components))
+	for i, c := range p.Components {
+		types[i] = c.CanonicalType()
+	}
+	return "(" + strings.Join(types, ",") + ")" + strings.TrimPrefix(p.Type, "tuple")
+}
+
+type AbiEntry struct {
+	Type            string     `json:"type"`
+	Name            string     `json:"name,omitempty"`
+	Inputs          []AbiParam `json:"inputs,omitempty"`
+	Outputs         []AbiParam `json:"outputs,omitempty"`
+	StateMutability string     `json:"stateMutability,omitempty"`
+	Anonymous       bool       `json:"anonymous,omitempty"`
+}
+
+// Signature returns the canonical signature, such as "transfer(address,uint256)"
+// for functions and events, or the entry type for constructor, fallback and
+// receive entries.
+func (e AbiEntry) Signature() string {
+	switch e.Type {
+	case "function", "event", "error":
+		types := make([]string, len(e.Inputs))
+		for i, in := range e.Inputs {
+			types[i] = in.CanonicalType()
+		}
+		return e.Name + "(" + strings.Join(types, ",") + ")"
+	default:
+		return e.Type
+	}
+}
+
+type Abi []AbiEntry
+
+// UnmarshalJSON accepts the ABI either as a JSON array or as a string
+// containing one, since verified sources often carry the ABI pre-encoded.
+func (a *Abi) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		if strings.TrimSpace(encoded) == "" {
+			*a = nil
+			return nil
+		}
+		data = []byte(encoded)
+	}
+
+	var entries []AbiEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("error decoding ABI: %w", err)
+	}
+	*a = entries
+	return nil
+}
+
+func (a Abi) Constructor() *AbiEntry {
+	for i := range a {
+		if a[i].Type == "constructor" {
+			return &a[i]
+		}
+	}
+	return nil
+}
+
+func (a Abi) Events() []AbiEntry {
+	return a.ofType("event")
+}
+
+func (a Abi) Functions() []AbiEntry {
+	return a.ofType("function")
+}
+
+func (a Abi) ofType(entryType string) []AbiEntry {
+	var entries []AbiEntry
+	for _, e := range a {
+		if e.Type == entryType {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+type SourceFormat string
+
+const (
+	SourceFormatSingleFile   SourceFormat = "single-file"
+	SourceFormatMultiFile    SourceFormat = "multi-file"
+	SourceFormatStandardJSON SourceFormat = "standard-json-input"
+)
+
+type SourceFile struct {
+	Path    string
+	Content string
+}
+
+type SourceBundle struct {
+	Format   SourceFormat
+	Language string
+	Files    []SourceFile
+	Settings json.RawMessage
+}
+
+type sourceContent struct {
+	Content string `json:"content"`
+}
+
+type standardJSONInput struct {
+	Language string                   `json:"language"`
+	Sources  map[string]sourceContent `json:"sources"`
+	Settings json.RawMessage          `json:"settings"`
+}
+
+// Bundle splits the verified source into files. The source code field holds
+// either plain Solidity, a JSON object mapping paths to contents, or a
+// standard JSON compiler input, optionally wrapped in an extra pair of braces.
+func (c *ContractSourceCode) Bundle() (*SourceBundle, error) {
+	code := strings.TrimSpace(c.SourceCode)
+	if code == "" {
+		return nil, fmt.Errorf("contract %s has no verified source code", c.ContractAddress)
+	}
+
+	if strings.HasPrefix(code, "{{") && strings.HasSuffix(code, "}}") {
+		code = code[1 : len(code)-1]
+	}
+
+	if strings.HasPrefix(code, "{") {
+		var input standardJSONInput
+		if err := json.Unmarshal([]byte(code), &input); err == nil && len(input.Sources) > 0 {
+			return &SourceBundle{
+				Format:   SourceFormatStandardJSON,
+				Language: input.Language,
+				Files:    sortedSourceFiles(input.Sources),
+				Settings: input.Settings,
+			}, nil
+		}
+
+		var sources map[string]sourceContent
+		if err := json.Unmarshal([]byte(code), &sources); err == nil && len(sources) > 0 {
+			return &SourceBundle{
+				Format:   SourceFormatMultiFile,
+				Language: "Solidity",
+				Files:    sortedSourceFiles(sources),
+			}, nil
+		}
+	}
+
+	name := c.ContractName
+	if name == "" {
+		name = "Contract"
+	}
+	return &SourceBundle{
+		Format:   SourceFormatSingleFile,
+		Language: "Solidity",
+		Files:    []SourceFile{{Path: name + ".sol", Content: c.SourceCode}},
+	}, nil
+}
+
+func sortedSourceFiles(sources map[string]sourceContent) []SourceFile {
+	files := make([]SourceFile, 0, len(sources))
+	for path, source := range sources {
+		files = append(files, SourceFile{Path: path, Content: source.Content})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files
+}
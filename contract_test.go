@@ -0,0 +1,84 @@
+package kaiascan
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetContractSourceCode_Bundle(t *testing.T) {
+	standardInput := `{{"language":"Solidity","sources":{"contracts/Token.sol":{"content":"contract Token {}"},` +
+		`"@openzeppelin/contracts/token/ERC20/ERC20.sol":{"content":"contract ERC20 {}"}},"settings":{"optimizer":{"enabled":true,"runs":200}}}}`
+	source := ContractSourceCode{
+		ContractAddress:  "0xcontract",
+		ContractName:     "Token",
+		CompilerVersion:  "v0.8.24+commit.e11b9ed9",
+		OptimizationRuns: 200,
+		SourceCode:       standardInput,
+	}
+	body, _ := json.Marshal(map[string]any{"code": 0, "msg": "Success", "data": map[string]any{
+		"contractAddress": source.ContractAddress,
+		"contractName":    source.ContractName,
+		"compilerVersion": source.CompilerVersion,
+		"sourceCode":      source.SourceCode,
+		"abi":             `[{"type":"constructor","inputs":[{"name":"supply","type":"uint256"}]},{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]}]`,
+	}})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/contracts/source-code" {
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	BASE_URL = server.URL + "/"
+
+	resp, err := GetContractSourceCode("0xcontract")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Data.Abi.Constructor() == nil || resp.Data.Abi.Functions()[0].Signature() != "transfer(address,uint256)" {
+		t.Errorf("Unexpected ABI: %+v", resp.Data.Abi)
+	}
+
+	bundle, err := resp.Data.Bundle()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if bundle.Format != SourceFormatStandardJSON || len(bundle.Files) != 2 {
+		t.Fatalf("Unexpected bundle: %+v", bundle)
+	}
+	if bundle.Files[0].Path != "@openzeppelin/contracts/token/ERC20/ERC20.sol" || bundle.Files[1].Content != "contract Token {}" {
+		t.Errorf("Unexpected files: %+v", bundle.Files)
+	}
+}
+
+func TestContractSourceCode_BundleFormats(t *testing.T) {
+	single := ContractSourceCode{ContractName: "Counter", SourceCode: "pragma solidity ^0.8.0;\ncontract Counter {}"}
+	bundle, err := single.Bundle()
+	if err != nil || bundle.Format != SourceFormatSingleFile || bundle.Files[0].Path != "Counter.sol" {
+		t.Errorf("Unexpected single-file bundle: %+v, %v", bundle, err)
+	}
+
+	multi := ContractSourceCode{SourceCode: `{"A.sol":{"content":"contract A {}"},"B.sol":{"content":"contract B {}"}}`}
+	bundle, err = multi.Bundle()
+	if err != nil || bundle.Format != SourceFormatMultiFile || len(bundle.Files) != 2 {
+		t.Errorf("Unexpected multi-file bundle: %+v, %v", bundle, err)
+	}
+
+	if _, err := (&ContractSourceCode{}).Bundle(); err == nil {
+		t.Error("Expected an error for unverified source")
+	}
+}
+
+func TestAbiEntry_SignatureWithTuple(t *testing.T) {
+	entry := AbiEntry{Type: "function", Name: "submit", Inputs: []AbiParam{
+		{Type: "tuple[]", Components: []AbiParam{{Type: "address"}, {Type: "uint256"}}},
+		{Type: "bytes32"},
+	}}
+	if got := entry.Signature(); got != "submit((address,uint256)[],bytes32)" {
+		t.Errorf("Unexpected signature: %s", got)
+	}
+}
@@ -0,0 +1,64 @@
+package kaiascan
+
+import (
+	"fmt"
+
+	"kaiascan.go/bytecode"
+)
+
+type ContractBytecode struct {
+	*bytecode.Analysis
+	ConstructorArguments []bytecode.Value
+}
+
+// AnalyzeContractBytecode splits the creation code of a contract and, when
+// its ABI is verified, decodes the constructor arguments.
+func AnalyzeContractBytecode(contractAddress string) (*ContractBytecode, error) {
+	return analyzeContractBytecode(NewClient(), contractAddress)
+}
+
+func analyzeContractBytecode(api ContractsAPI, contractAddress string) (*ContractBytecode, error) {
+	if contractAddress == "" {
+		return nil, fmt.Errorf("contract address is required")
+	}
+
+	resp, err := api.GetContractCreationCode(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	creation, err := bytecode.DecodeHex(resp.Data.CreationCode)
+	if err != nil {
+		return nil, err
+	}
+	analysis, err := bytecode.Analyze(creation, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &ContractBytecode{Analysis: analysis}
+
+	abi, err := api.GetContractAbi(contractAddress)
+	if isNotFound(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching ABI: %w", err)
+	}
+
+	constructor := abi.Data.Abi.Constructor()
+	if constructor == nil || len(constructor.Inputs) == 0 {
+		return result, nil
+	}
+	result.ConstructorArguments, err = bytecode.DecodeArguments(bytecodeArguments(constructor.Inputs), analysis.ConstructorArgs)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding constructor arguments: %w", err)
+	}
+	return result, nil
+}
+
+func bytecodeArguments(params []AbiParam) []bytecode.Argument {
+	args := make([]bytecode.Argument, len(params))
+	for i, p := range params {
+		args[i] = bytecode.Argument{Name: p.Name, Type: p.Type, Components: bytecodeArguments(p.Components)}
+	}
+	return args
+}
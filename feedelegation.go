@@ -0,0 +1,249 @@
+package kaiascan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"kaiascan.go/units"
+)
+
+type FeeDelegationOptions struct {
+	BlockNumberStart *int
+	BlockNumberEnd   *int
+	// Since and Until bound the report by transaction time, as the half-open
+	// interval [Since, Until). Zero values leave the bound open.
+	Since time.Time
+	Until time.Time
+	// OutlierScore is the modified z-score above which a sender's total fees
+	// are flagged as unusual. Defaults to 3.5.
+	OutlierScore float64
+	// API is where transactions and blocks are fetched from. A nil API means
+	// a Client.
+	API interface {
+		BlocksAPI
+		AccountsAPI
+	}
+}
+
+type FeeAggregate struct {
+	Key             string       `json:"key"`
+	Transactions    int          `json:"transactions"`
+	Fees            units.Amount `json:"fees"`
+	GasUsed         int64        `json:"gasUsed"`
+	AverageGasPrice units.Amount `json:"averageGasPrice"`
+}
+
+type UnusualSpender struct {
+	Sender       string       `json:"sender"`
+	Transactions int          `json:"transactions"`
+	Fees         units.Amount `json:"fees"`
+	Score        float64      `json:"score"`
+}
+
+// FeeDelegationReport summarizes the fees an account paid. Fees are in kei
+// and only count the payer's share of partially delegated transactions;
+// average gas prices are total fees over total gas used.
+type FeeDelegationReport struct {
+	FeePayer        string           `json:"feePayer"`
+	Transactions    int              `json:"transactions"`
+	TotalFees       units.Amount     `json:"totalFees"`
+	GasUsed         int64            `json:"gasUsed"`
+	AverageGasPrice units.Amount     `json:"averageGasPrice"`
+	BySender        []FeeAggregate   `json:"bySender"`
+	ByDay           []FeeAggregate   `json:"byDay"`
+	ByType          []FeeAggregate   `json:"byType"`
+	Unusual         []UnusualSpender `json:"unusual"`
+}
+
+func AnalyzeFeeDelegation(ctx context.Context, feePayer string, opts FeeDelegationOptions) (*FeeDelegationReport, error) {
+	if feePayer == "" {
+		return nil, fmt.Errorf("fee payer address is required")
+	}
+	api := apiOrDefault(opts.API)
+
+	start := opts.BlockNumberStart
+	if start == nil && !opts.Since.IsZero() {
+		block, err := blockAtOrAfter(ctx, api, opts.Since)
+		if err != nil {
+			return nil, fmt.Errorf("error finding the first block of the window: %w", err)
+		}
+		n := int(block.BlockNumber)
+		start = &n
+	}
+
+	end := opts.BlockNumberEnd
+	if end == nil && !opts.Until.IsZero() {
+		block, err := blockAtOrBefore(ctx, api, opts.Until.Add(-time.Nanosecond))
+		if err != nil {
+			return nil, fmt.Errorf("error finding the last block of the window: %w", err)
+		}
+		n := int(block.BlockNumber)
+		end = &n
+	}
+
+	transactions, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[Transaction]], error) {
+		return api.GetFeePaidTransactions(feePayer, page, size, start, end, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching fee paid transactions: %w", err)
+	}
+	return FeeDelegation(feePayer, transactions, opts), nil
+}
+
+// FeeDelegation aggregates the fees feePayer paid for transactions, such as
+// every page of GetFeePaidTransactions, per sender, day (UTC) and transaction
+// type.
+func FeeDelegation(feePayer string, transactions []Transaction, opts FeeDelegationOptions) *FeeDelegationReport {
+	payer := strings.ToLower(feePayer)
+	total := newFeeTally("")
+	bySender, byDay, byType := map[string]*feeTally{}, map[string]*feeTally{}, map[string]*feeTally{}
+	add := func(groups map[string]*feeTally, key string, tx Transaction, fee *big.Int) {
+		if groups[key] == nil {
+			groups[key] = newFeeTally(key)
+		}
+		groups[key].add(tx, fee)
+	}
+
+	for _, tx := range transactions {
+		if opts.BlockNumberStart != nil && tx.BlockNumber < int64(*opts.BlockNumberStart) ||
+			opts.BlockNumberEnd != nil && tx.BlockNumber > int64(*opts.BlockNumberEnd) ||
+			!opts.Since.IsZero() && tx.Datetime.Before(opts.Since) ||
+			!opts.Until.IsZero() && !tx.Datetime.Before(opts.Until) {
+			continue
+		}
+		fee := feeShare(tx, payer)
+		if fee.Sign() == 0 {
+			continue
+		}
+		txType := tx.TransactionType
+		if txType == "" {
+			txType = "unknown"
+		}
+		total.add(tx, fee)
+		add(bySender, strings.ToLower(tx.From), tx, fee)
+		add(byDay, tx.Datetime.UTC().Format(time.DateOnly), tx, fee)
+		add(byType, txType, tx, fee)
+	}
+
+	report := &FeeDelegationReport{
+		FeePayer: feePayer,
+		BySender: sortedFeeAggregates(bySender, true),
+		ByDay:    sortedFeeAggregates(byDay, false),
+		ByType:   sortedFeeAggregates(byType, true),
+	}
+	summary := total.aggregate()
+	report.Transactions = summary.Transactions
+	report.TotalFees = summary.Fees
+	report.GasUsed = summary.GasUsed
+	report.AverageGasPrice = summary.AverageGasPrice
+
+	threshold := opts.OutlierScore
+	if threshold == 0 {
+		threshold = 3.5
+	}
+	report.Unusual = unusualSpenders(report.BySender, threshold)
+	return report
+}
+
+type feeTally struct {
+	key          string
+	transactions int
+	fees         *big.Int
+	gasFees      *big.Int
+	gasUsed      int64
+}
+
+func newFeeTally(key string) *feeTally {
+	return &feeTally{key: key, fees: new(big.Int), gasFees: new(big.Int)}
+}
+
+// add counts the payer's share in fees and the whole fee in gasFees, so the
+// average gas price reflects what the network charged.
+func (t *feeTally) add(tx Transaction, fee *big.Int) {
+	t.transactions++
+	t.fees.Add(t.fees, fee)
+	t.gasFees.Add(t.gasFees, tx.TransactionFee.Big())
+	t.gasUsed += tx.GasUsed
+}
+
+func (t *feeTally) aggregate() FeeAggregate {
+	a := FeeAggregate{
+		Key:          t.key,
+		Transactions: t.transactions,
+		Fees:         units.NewAmount(t.fees),
+		GasUsed:      t.gasUsed,
+	}
+	if t.gasUsed > 0 {
+		a.AverageGasPrice = units.NewAmount(new(big.Int).Div(t.gasFees, big.NewInt(t.gasUsed)))
+	}
+	return a
+}
+
+func sortedFeeAggregates(groups map[string]*feeTally, byFees bool) []FeeAggregate {
+	aggregates := make([]FeeAggregate, 0, len(groups))
+	for _, t := range groups {
+		aggregates = append(aggregates, t.aggregate())
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		if byFees {
+			if c := aggregates[i].Fees.Cmp(aggregates[j].Fees); c != 0 {
+				return c > 0
+			}
+		}
+		return aggregates[i].Key < aggregates[j].Key
+	})
+	return aggregates
+}
+
+// unusualSpenders flags senders whose total fees have a modified z-score
+// (0.6745 * (x - median) / MAD) above threshold. When more than half of the
+// senders spend the same amount the MAD is zero, and the mean absolute
+// deviation scaled by 1.253314 is used instead.
+func unusualSpenders(senders []FeeAggregate, threshold float64) []UnusualSpender {
+	unusual := []UnusualSpender{}
+	if len(senders) < 3 {
+		return unusual
+	}
+
+	fees := make([]float64, len(senders))
+	for i, s := range senders {
+		fees[i], _ = new(big.Float).SetInt(s.Fees.Big()).Float64()
+	}
+	m := median(fees)
+	deviations := make([]float64, len(fees))
+	var sum float64
+	for i, f := range fees {
+		deviations[i] = math.Abs(f - m)
+		sum += deviations[i]
+	}
+
+	scale := median(deviations) / 0.6745
+	if scale == 0 {
+		scale = 1.253314 * sum / float64(len(fees))
+	}
+	if scale == 0 {
+		return unusual
+	}
+
+	for i, s := range senders {
+		if score := (fees[i] - m) / scale; score > threshold {
+			unusual = append(unusual, UnusualSpender{Sender: s.Key, Transactions: s.Transactions, Fees: s.Fees, Score: score})
+		}
+	}
+	return unusual
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
@@ -0,0 +1,115 @@
+package kaiascan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// feeChainStart is when block feeChainBase was produced; the fee fixtures
+// assume one block per second from there.
+var feeChainStart = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+const feeChainBase = 150_000_000
+
+func feeBlockAt(at time.Time) int64 {
+	return feeChainBase + at.Unix() - feeChainStart.Unix()
+}
+
+func feePaidFixture() []Transaction {
+	day1 := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	tx := func(hash string, from string, at time.Time, txType string, fee int64, gas int64) Transaction {
+		return Transaction{TransactionHash: hash, BlockNumber: feeBlockAt(at), Datetime: at, From: from, FeePayer: "0xRelayer", FeeRatio: 100, TransactionType: txType, TransactionFee: amountOf(fee), GasUsed: gas}
+	}
+	partial := tx("0x6", "0xa", day2, "TxTypeFeeDelegatedValueTransferWithRatio", 1000, 100)
+	partial.FeeRatio = 30
+	return []Transaction{
+		tx("0x1", "0xa", day1, "TxTypeFeeDelegatedSmartContractExecution", 100, 10),
+		tx("0x2", "0xb", day1, "TxTypeFeeDelegatedSmartContractExecution", 100, 10),
+		tx("0x3", "0xc", day1, "TxTypeFeeDelegatedSmartContractExecution", 100, 10),
+		tx("0x4", "0xd", day2, "TxTypeFeeDelegatedSmartContractExecution", 100, 10),
+		tx("0x5", "0xe", day2, "TxTypeFeeDelegatedSmartContractExecution", 5000, 500),
+		partial,
+		tx("0x7", "0xa", day2.Add(24*time.Hour), "TxTypeFeeDelegatedSmartContractExecution", 100, 10),
+	}
+}
+
+func TestFeeDelegation(t *testing.T) {
+	until := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	report := FeeDelegation("0xrelayer", feePaidFixture(), FeeDelegationOptions{Until: until})
+
+	if report.Transactions != 6 || report.TotalFees.String() != "5700" || report.GasUsed != 640 {
+		t.Fatalf("Unexpected totals: %+v", report)
+	}
+	// (4*100 + 5000 + 1000) / 640, using the full fee of the partial delegation
+	if report.AverageGasPrice.String() != "10" {
+		t.Errorf("Unexpected average gas price: %s", report.AverageGasPrice)
+	}
+
+	if len(report.BySender) != 5 || report.BySender[0].Key != "0xe" || report.BySender[1].Key != "0xa" || report.BySender[1].Fees.String() != "400" {
+		t.Errorf("Unexpected senders: %+v", report.BySender)
+	}
+	if len(report.ByDay) != 2 || report.ByDay[0].Key != "2025-03-01" || report.ByDay[0].Fees.String() != "300" || report.ByDay[1].Transactions != 3 {
+		t.Errorf("Unexpected days: %+v", report.ByDay)
+	}
+	if len(report.ByType) != 2 || report.ByType[0].Key != "TxTypeFeeDelegatedSmartContractExecution" || report.ByType[1].Fees.String() != "300" {
+		t.Errorf("Unexpected types: %+v", report.ByType)
+	}
+
+	if len(report.Unusual) != 1 || report.Unusual[0].Sender != "0xe" {
+		t.Errorf("Expected 0xe to be flagged, got %+v", report.Unusual)
+	}
+}
+
+func TestAnalyzeFeeDelegation(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/accounts/0xrelayer/fee-paid-transactions"):
+			query = r.URL.Query()
+			w.Write(mockApiResponse(Page[Transaction]{Paging: Paging{Last: true}, Results: feePaidFixture()}, 0, "Success"))
+		case strings.Contains(r.URL.Path, "/blocks/timestamps/"):
+			ts, _ := strconv.ParseInt(path.Base(r.URL.Path), 10, 64)
+			at := time.Unix(ts, 0).UTC()
+			w.Write(mockApiResponse([]Block{{BlockNumber: feeBlockAt(at), Datetime: at}}, 0, "Success"))
+		default:
+			t.Errorf("Unexpected API path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	end := int(feeBlockAt(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)))
+	report, err := AnalyzeFeeDelegation(context.Background(), "0xrelayer", FeeDelegationOptions{BlockNumberEnd: &end})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("blockNumberEnd") != "150043200" {
+		t.Errorf("Expected the block window to be passed through, got %v", query)
+	}
+	if report.Transactions != 3 || len(report.Unusual) != 0 {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+
+	report, err = AnalyzeFeeDelegation(context.Background(), "0xrelayer", FeeDelegationOptions{
+		Since: feeChainStart,
+		Until: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if query.Get("blockNumberStart") != "150000000" || query.Get("blockNumberEnd") != "150172799" {
+		t.Errorf("Expected Since and Until to become block bounds, got %v", query)
+	}
+	if report.Transactions != 6 {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+}
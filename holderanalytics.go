@@ -0,0 +1,245 @@
+package kaiascan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"kaiascan.go/units"
+)
+
+const deadAddress = "0x000000000000000000000000000000000000dead"
+
+type HolderDistributionOptions struct {
+	// TopN lists the top-N cut-offs to report shares for. Defaults to 10 and
+	// 100. Cut-offs must be >= 1.
+	TopN []int
+	// Exclusions maps a label such as "treasury" to addresses left out of
+	// every metric. Their balances are reported separately.
+	Exclusions map[string][]string
+	// ExcludeBurnAddresses adds the zero and 0x...dead addresses under the
+	// "burn" label.
+	ExcludeBurnAddresses bool
+	// BucketBounds are raw balance boundaries. When empty, powers
+	// of ten spanning the observed balances are used.
+	BucketBounds []units.Amount
+	// API is where holders are fetched from. A nil API means a Client.
+	API TokensAPI
+}
+
+type ExcludedHolding struct {
+	Label   string       `json:"label"`
+	Address string       `json:"address"`
+	Balance units.Amount `json:"balance"`
+}
+
+type TopHolderShare struct {
+	N          int     `json:"n"`
+	Percentage float64 `json:"percentage"`
+}
+
+type BalanceBucket struct {
+	Min        units.Amount  `json:"min"`
+	Max        *units.Amount `json:"max,omitempty"`
+	Holders    int           `json:"holders"`
+	Balance    units.Amount  `json:"balance"`
+	Percentage float64       `json:"percentage"`
+}
+
+type HolderDistributionReport struct {
+	TokenAddress string            `json:"tokenAddress"`
+	HolderCount  int               `json:"holderCount"`
+	TotalBalance units.Amount      `json:"totalBalance"`
+	Excluded     []ExcludedHolding `json:"excluded,omitempty"`
+	TopShares    []TopHolderShare  `json:"topShares"`
+	Gini         float64           `json:"gini"`
+	Nakamoto     int               `json:"nakamoto"`
+	Buckets      []BalanceBucket   `json:"buckets"`
+}
+
+func AnalyzeTokenHolders(ctx context.Context, tokenAddress string, opts HolderDistributionOptions) (*HolderDistributionReport, error) {
+	if tokenAddress == "" {
+		return nil, fmt.Errorf("token address is required")
+	}
+	for _, n := range opts.TopN {
+		if n < 1 {
+			return nil, fmt.Errorf("top-N cut-off must be >= 1, got %d", n)
+		}
+	}
+
+	holders, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenHolder]], error) {
+		return apiOrDefault(opts.API).GetTokenHolders(tokenAddress, page, size, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token holders: %w", err)
+	}
+
+	report := HolderDistribution(holders, opts)
+	report.TokenAddress = tokenAddress
+	return report, nil
+}
+
+// HolderDistribution computes concentration metrics over a holder list, such
+// as every page of GetTokenHolders or a historical snapshot. Top-N cut-offs
+// below 1 are skipped.
+func HolderDistribution(holders []TokenHolder, opts HolderDistributionOptions) *HolderDistributionReport {
+	excluded := map[string]string{}
+	for label, addresses := range opts.Exclusions {
+		for _, address := range addresses {
+			excluded[strings.ToLower(address)] = label
+		}
+	}
+	if opts.ExcludeBurnAddresses {
+		excluded[zeroAddress] = "burn"
+		excluded[deadAddress] = "burn"
+	}
+
+	report := &HolderDistributionReport{}
+	balances := map[string]*big.Int{}
+	for _, h := range holders {
+		address := strings.ToLower(h.HolderAddress)
+		if h.Amount.Sign() <= 0 {
+			continue
+		}
+		if label, ok := excluded[address]; ok {
+			report.Excluded = append(report.Excluded, ExcludedHolding{Label: label, Address: address, Balance: h.Amount})
+			continue
+		}
+		if balances[address] == nil {
+			balances[address] = new(big.Int)
+		}
+		balances[address].Add(balances[address], h.Amount.Big())
+	}
+	sort.Slice(report.Excluded, func(i, j int) bool {
+		if report.Excluded[i].Label != report.Excluded[j].Label {
+			return report.Excluded[i].Label < report.Excluded[j].Label
+		}
+		return report.Excluded[i].Address < report.Excluded[j].Address
+	})
+
+	sorted := make([]*big.Int, 0, len(balances))
+	total := new(big.Int)
+	for _, balance := range balances {
+		sorted = append(sorted, balance)
+		total.Add(total, balance)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) > 0 })
+
+	report.HolderCount = len(sorted)
+	report.TotalBalance = units.NewAmount(total)
+
+	topN := opts.TopN
+	if len(topN) == 0 {
+		topN = []int{10, 100}
+	}
+	for _, n := range topN {
+		if n < 1 {
+			continue
+		}
+		sum := new(big.Int)
+		for _, b := range sorted[:min(n, len(sorted))] {
+			sum.Add(sum, b)
+		}
+		report.TopShares = append(report.TopShares, TopHolderShare{N: n, Percentage: percentage(sum, total)})
+	}
+
+	report.Gini = gini(sorted, total)
+	report.Nakamoto = nakamoto(sorted, total)
+	report.Buckets = balanceBuckets(sorted, total, opts.BucketBounds)
+	return report
+}
+
+// gini expects balances in descending order.
+func gini(sorted []*big.Int, total *big.Int) float64 {
+	n := int64(len(sorted))
+	if n == 0 || total.Sign() == 0 {
+		return 0
+	}
+
+	// With balances ascending and ranks i = 1..n:
+	// G = 2*sum(i*x_i) / (n*sum(x)) - (n+1)/n
+	weighted := new(big.Int)
+	for i, b := range sorted {
+		rank := big.NewInt(n - int64(i))
+		weighted.Add(weighted, new(big.Int).Mul(rank, b))
+	}
+	g := new(big.Rat).SetFrac(new(big.Int).Lsh(weighted, 1), new(big.Int).Mul(big.NewInt(n), total))
+	g.Sub(g, big.NewRat(n+1, n))
+	f, _ := g.Float64()
+	return f
+}
+
+// nakamoto returns the smallest number of holders that together hold more
+// than half of the total, given balances in descending order.
+func nakamoto(sorted []*big.Int, total *big.Int) int {
+	if total.Sign() == 0 {
+		return 0
+	}
+	sum := new(big.Int)
+	for i, b := range sorted {
+		sum.Add(sum, b)
+		if new(big.Int).Lsh(sum, 1).Cmp(total) > 0 {
+			return i + 1
+		}
+	}
+	return len(sorted)
+}
+
+func balanceBuckets(sorted []*big.Int, total *big.Int, bounds []units.Amount) []BalanceBucket {
+	if len(sorted) == 0 {
+		return []BalanceBucket{}
+	}
+
+	limits := make([]*big.Int, 0, len(bounds))
+	for _, b := range bounds {
+		limits = append(limits, b.Big())
+	}
+	if len(limits) == 0 {
+		smallest, largest := sorted[len(sorted)-1], sorted[0]
+		bound := big.NewInt(1)
+		for bound.Cmp(smallest) <= 0 {
+			bound.Mul(bound, big.NewInt(10))
+		}
+		bound.Div(bound, big.NewInt(10))
+		for bound.Cmp(largest) <= 0 {
+			limits = append(limits, new(big.Int).Set(bound))
+			bound.Mul(bound, big.NewInt(10))
+		}
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Cmp(limits[j]) < 0 })
+	if len(limits) == 0 || sorted[len(sorted)-1].Cmp(limits[0]) < 0 {
+		limits = append([]*big.Int{new(big.Int)}, limits...)
+	}
+
+	buckets := make([]BalanceBucket, len(limits))
+	sums := make([]*big.Int, len(limits))
+	for i, lower := range limits {
+		buckets[i].Min = units.NewAmount(lower)
+		if i+1 < len(limits) {
+			upper := units.NewAmount(limits[i+1])
+			buckets[i].Max = &upper
+		}
+		sums[i] = new(big.Int)
+	}
+
+	for _, b := range sorted {
+		i := sort.Search(len(limits), func(i int) bool { return limits[i].Cmp(b) > 0 }) - 1
+		buckets[i].Holders++
+		sums[i].Add(sums[i], b)
+	}
+	for i := range buckets {
+		buckets[i].Balance = units.NewAmount(sums[i])
+		buckets[i].Percentage = percentage(sums[i], total)
+	}
+	return buckets
+}
+
+func percentage(part *big.Int, total *big.Int) float64 {
+	if total.Sign() == 0 {
+		return 0
+	}
+	f, _ := new(big.Rat).SetFrac(new(big.Int).Mul(part, big.NewInt(100)), total).Float64()
+	return f
+}
@@ -0,0 +1,83 @@
+package kaiascan
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeTokenHolders(t *testing.T) {
+	holders := []TokenHolder{
+		{HolderAddress: "0xWhale", Amount: amountOf(5000)},
+		{HolderAddress: "0xtreasury", Amount: amountOf(100000)},
+		{HolderAddress: zeroAddress, Amount: amountOf(7777)},
+		{HolderAddress: "0xa", Amount: amountOf(3000)},
+		{HolderAddress: "0xb", Amount: amountOf(1500)},
+		{HolderAddress: "0xc", Amount: amountOf(400)},
+		{HolderAddress: "0xd", Amount: amountOf(100)},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/tokens/0xtoken/holders") {
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+		w.Write(mockApiResponse(Page[TokenHolder]{Paging: Paging{Last: true}, Results: holders}, 0, "Success"))
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	report, err := AnalyzeTokenHolders(context.Background(), "0xtoken", HolderDistributionOptions{
+		TopN:                 []int{1, 2},
+		Exclusions:           map[string][]string{"treasury": {"0xTreasury"}},
+		ExcludeBurnAddresses: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if report.HolderCount != 5 || report.TotalBalance.String() != "10000" {
+		t.Fatalf("Unexpected totals: %d holders, %s", report.HolderCount, report.TotalBalance)
+	}
+	if len(report.Excluded) != 2 || report.Excluded[0].Label != "burn" || report.Excluded[1].Label != "treasury" {
+		t.Errorf("Unexpected exclusions: %+v", report.Excluded)
+	}
+	if report.TopShares[0].Percentage != 50 || report.TopShares[1].Percentage != 80 {
+		t.Errorf("Unexpected top shares: %+v", report.TopShares)
+	}
+	if report.Nakamoto != 2 {
+		t.Errorf("Expected Nakamoto coefficient 2, got %d", report.Nakamoto)
+	}
+	// Ascending 100, 400, 1500, 3000, 5000: sum(i*x) = 100+800+4500+12000+25000 = 42400,
+	// G = 2*42400/(5*10000) - 6/5 = 0.496
+	if math.Abs(report.Gini-0.496) > 1e-9 {
+		t.Errorf("Expected Gini 0.496, got %f", report.Gini)
+	}
+
+	if len(report.Buckets) != 2 || report.Buckets[0].Min.String() != "100" || report.Buckets[0].Holders != 2 || report.Buckets[1].Holders != 3 {
+		t.Errorf("Unexpected buckets: %+v", report.Buckets)
+	}
+	if report.Buckets[1].Max != nil {
+		t.Errorf("Expected the last bucket to be open-ended")
+	}
+}
+
+func TestHolderDistribution_Empty(t *testing.T) {
+	report := HolderDistribution(nil, HolderDistributionOptions{})
+	if report.HolderCount != 0 || report.Gini != 0 || report.Nakamoto != 0 || len(report.Buckets) != 0 {
+		t.Errorf("Unexpected empty report: %+v", report)
+	}
+}
+
+func TestHolderDistribution_InvalidTopN(t *testing.T) {
+	holders := []TokenHolder{{HolderAddress: "0xalice", Amount: amountOf(10)}}
+	report := HolderDistribution(holders, HolderDistributionOptions{TopN: []int{-1, 0, 1}})
+	if len(report.TopShares) != 1 || report.TopShares[0].N != 1 || report.TopShares[0].Percentage != 100 {
+		t.Errorf("Expected non-positive cut-offs to be skipped, got %+v", report.TopShares)
+	}
+
+	if _, err := AnalyzeTokenHolders(context.Background(), "0xtoken", HolderDistributionOptions{TopN: []int{-5}}); err == nil {
+		t.Error("Expected an error for a negative cut-off")
+	}
+}
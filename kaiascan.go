@@ -2,12 +2,16 @@ package kaiascan
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
+
+	"kaiascan.go/units"
 )
 
 var (
@@ -41,6 +45,13 @@ func ConfigureSDK(isTestnet bool) {
 	}
 }
 
+// ConfigureTransport replaces the HTTP transport used for every request,
+// for example to record or replay responses in tests. A nil transport
+// restores http.DefaultTransport.
+func ConfigureTransport(transport http.RoundTripper) {
+	httpClient = &http.Client{Timeout: httpClient.Timeout, Transport: transport}
+}
+
 type Address = string
 
 type ApiResponse[T any] struct {
@@ -62,6 +73,382 @@ type TokenInfo struct {
 	TotalBurns     int64   `json:"totalBurns"`
 }
 
+type Block struct {
+	BlockNumber           int64     `json:"blockId"`
+	Hash                  string    `json:"hash"`
+	ParentHash            string    `json:"parentHash"`
+	Datetime              time.Time `json:"datetime"`
+	TotalTransactionCount int64     `json:"totalTransactionCount"`
+	BlockProposer         string    `json:"blockProposer"`
+	GasUsed               int64     `json:"gasUsed"`
+	BlockSize             int64     `json:"blockSize"`
+}
+
+type Paging struct {
+	TotalCount  int64 `json:"totalCount"`
+	CurrentPage int   `json:"currentPage"`
+	Last        bool  `json:"last"`
+	TotalPage   int   `json:"totalPage"`
+}
+
+type Page[T any] struct {
+	Paging  Paging `json:"paging"`
+	Results []T    `json:"results"`
+}
+
+type Transaction struct {
+	TransactionHash string       `json:"transactionHash"`
+	BlockNumber     int64        `json:"blockId"`
+	Datetime        time.Time    `json:"datetime"`
+	From            string       `json:"from"`
+	To              string       `json:"to"`
+	TransactionType string       `json:"transactionType"`
+	Status          string       `json:"status"`
+	Nonce           int64        `json:"nonce"`
+	Amount          units.Amount `json:"amount"`
+	TransactionFee  units.Amount `json:"transactionFee"`
+	GasPrice        units.Amount `json:"gasPrice"`
+	GasUsed         int64        `json:"gasUsed"`
+	FeePayer        string       `json:"feePayer"`
+	FeeRatio        int          `json:"feeRatio"`
+}
+
+type AccountInfo struct {
+	Address               string       `json:"address"`
+	AccountType           string       `json:"accountType"`
+	Balance               units.Amount `json:"balance"`
+	TotalTransactionCount int64        `json:"totalTransactionCount"`
+}
+
+type NftKind string
+
+const (
+	NftKindKIP17 NftKind = "kip17"
+	NftKindKIP37 NftKind = "kip37"
+)
+
+func (k *NftKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(strings.ReplaceAll(s, "-", "")) {
+	case "kip17", "erc721":
+		*k = NftKindKIP17
+	case "kip37", "erc1155":
+		*k = NftKindKIP37
+	default:
+		*k = NftKind(s)
+	}
+	return nil
+}
+
+type TokenTransfer struct {
+	TransactionHash string       `json:"transactionHash"`
+	LogIndex        int          `json:"logIndex"`
+	BlockNumber     int64        `json:"blockId"`
+	Datetime        time.Time    `json:"datetime"`
+	From            string       `json:"from"`
+	To              string       `json:"to"`
+	ContractAddress string       `json:"contractAddress"`
+	Amount          units.Amount `json:"amount"`
+}
+
+type NftTransfer struct {
+	TransactionHash string       `json:"transactionHash"`
+	LogIndex        int          `json:"logIndex"`
+	BlockNumber     int64        `json:"blockId"`
+	Datetime        time.Time    `json:"datetime"`
+	From            string       `json:"from"`
+	To              string       `json:"to"`
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"contractType"`
+	TokenId         string       `json:"tokenId"`
+	Quantity        units.Amount `json:"tokenCount"`
+}
+
+type ContractInfo struct {
+	ContractAddress         string `json:"contractAddress"`
+	Name                    string `json:"contractName"`
+	Verified                bool   `json:"verified"`
+	CompilerVersion         string `json:"compilerVersion"`
+	OptimizationEnabled     bool   `json:"optimizationFlag"`
+	OptimizationRuns        int    `json:"optimizationRuns"`
+	EvmVersion              string `json:"evmVersion"`
+	License                 string `json:"licenseType"`
+	Proxy                   bool   `json:"proxy"`
+	ImplementationAddress   string `json:"implementationAddress"`
+	Deployer                string `json:"deployer"`
+	CreationTransactionHash string `json:"createdTransactionHash"`
+}
+
+type ContractSourceCode struct {
+	ContractAddress      string `json:"contractAddress"`
+	ContractName         string `json:"contractName"`
+	CompilerVersion      string `json:"compilerVersion"`
+	OptimizationEnabled  bool   `json:"optimizationFlag"`
+	OptimizationRuns     int    `json:"optimizationRuns"`
+	EvmVersion           string `json:"evmVersion"`
+	License              string `json:"licenseType"`
+	SourceCode           string `json:"sourceCode"`
+	ConstructorArguments string `json:"constructorArguments"`
+	Abi                  Abi    `json:"abi"`
+}
+
+type ContractCreationCode struct {
+	ContractAddress string `json:"contractAddress"`
+	CreationCode    string `json:"creationCode"`
+}
+
+type ContractAbi struct {
+	ContractAddress string `json:"contractAddress"`
+	Abi             Abi    `json:"abi"`
+}
+
+type EventLog struct {
+	TransactionHash string    `json:"transactionHash"`
+	LogIndex        int       `json:"logIndex"`
+	BlockNumber     int64     `json:"blockId"`
+	Datetime        time.Time `json:"datetime"`
+	Address         string    `json:"address"`
+	Signature       string    `json:"signature"`
+	Topics          []string  `json:"topics"`
+	Data            string    `json:"data"`
+}
+
+type NftCollection struct {
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"contractType"`
+	Name            string       `json:"name"`
+	Symbol          string       `json:"symbol"`
+	Icon            string       `json:"icon"`
+	TotalSupply     units.Amount `json:"totalSupply"`
+	TotalTransfers  int64        `json:"totalTransfers"`
+	HolderCount     int64        `json:"holderCount"`
+	OfficialSite    string       `json:"officialSite"`
+}
+
+type NftAttribute struct {
+	TraitType   string `json:"trait_type"`
+	Value       any    `json:"value"`
+	DisplayType string `json:"display_type,omitempty"`
+}
+
+// NftMetadata is third-party JSON, so it is decoded leniently: fields with
+// unexpected types are left empty and the original document is kept in Raw.
+type NftMetadata struct {
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Image        string          `json:"image"`
+	ExternalUrl  string          `json:"external_url,omitempty"`
+	AnimationUrl string          `json:"animation_url,omitempty"`
+	Attributes   []NftAttribute  `json:"attributes,omitempty"`
+	Raw          json.RawMessage `json:"raw,omitempty"`
+}
+
+func (m *NftMetadata) UnmarshalJSON(data []byte) error {
+	*m = NftMetadata{}
+
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		if strings.TrimSpace(encoded) == "" {
+			return nil
+		}
+		data = []byte(encoded)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		m.Raw = rawMetadata(data)
+		return nil
+	}
+
+	ok := true
+	for name, target := range map[string]*string{
+		"name":          &m.Name,
+		"description":   &m.Description,
+		"image":         &m.Image,
+		"external_url":  &m.ExternalUrl,
+		"animation_url": &m.AnimationUrl,
+	} {
+		if raw, present := fields[name]; present {
+			ok = lenientString(raw, target) && ok
+		}
+	}
+	if raw, present := fields["attributes"]; present {
+		ok = m.decodeAttributes(raw) && ok
+	}
+	if !ok {
+		m.Raw = rawMetadata(data)
+	}
+	return nil
+}
+
+// decodeAttributes accepts the usual array of attributes as well as an object
+// mapping trait types to values. Malformed entries are skipped.
+func (m *NftMetadata) decodeAttributes(raw json.RawMessage) bool {
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		ok := true
+		for _, item := range list {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(item, &fields); err != nil {
+				ok = false
+				continue
+			}
+			var attribute NftAttribute
+			ok = lenientString(fields["trait_type"], &attribute.TraitType) && ok
+			ok = lenientString(fields["display_type"], &attribute.DisplayType) && ok
+			json.Unmarshal(fields["value"], &attribute.Value)
+			m.Attributes = append(m.Attributes, attribute)
+		}
+		return ok
+	}
+
+	var traits map[string]any
+	if err := json.Unmarshal(raw, &traits); err != nil {
+		return string(raw) == "null"
+	}
+	names := make([]string, 0, len(traits))
+	for name := range traits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		m.Attributes = append(m.Attributes, NftAttribute{TraitType: name, Value: traits[name]})
+	}
+	return false
+}
+
+// lenientString decodes a JSON string into target, falling back to the
+// literal text of numbers and booleans. It reports whether raw was a string
+// or absent.
+func lenientString(raw json.RawMessage, target *string) bool {
+	if len(raw) == 0 || string(raw) == "null" {
+		return true
+	}
+	if err := json.Unmarshal(raw, target); err == nil {
+		return true
+	}
+	var scalar any
+	if err := json.Unmarshal(raw, &scalar); err == nil {
+		switch scalar.(type) {
+		case float64, bool:
+			*target = string(raw)
+		}
+	}
+	return false
+}
+
+// rawMetadata keeps data as-is when it is valid JSON and as a JSON string
+// otherwise, so NftMetadata always marshals.
+func rawMetadata(data []byte) json.RawMessage {
+	if json.Valid(data) {
+		return append(json.RawMessage(nil), data...)
+	}
+	encoded, _ := json.Marshal(string(data))
+	return encoded
+}
+
+type NftItem struct {
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"contractType"`
+	TokenId         string       `json:"tokenId"`
+	Owner           string       `json:"owner"`
+	TokenUri        string       `json:"tokenUri"`
+	Metadata        *NftMetadata `json:"metadata"`
+	TotalSupply     units.Amount `json:"totalSupply"`
+	TotalTransfers  int64        `json:"totalTransfers"`
+}
+
+type NftHolder struct {
+	HolderAddress string       `json:"holderAddress"`
+	TokenId       string       `json:"tokenId"`
+	TokenCount    units.Amount `json:"tokenCount"`
+}
+
+type NftInventoryEntry struct {
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"contractType"`
+	TokenId         string       `json:"tokenId"`
+	HolderAddress   string       `json:"holderAddress"`
+	TokenUri        string       `json:"tokenUri"`
+	TokenCount      units.Amount `json:"tokenCount"`
+}
+
+type TokenHolder struct {
+	HolderAddress string       `json:"holderAddress"`
+	Amount        units.Amount `json:"amount"`
+}
+
+type TokenBurn struct {
+	TransactionHash string       `json:"transactionHash"`
+	LogIndex        int          `json:"logIndex"`
+	BlockNumber     int64        `json:"blockId"`
+	Datetime        time.Time    `json:"datetime"`
+	From            string       `json:"from"`
+	Amount          units.Amount `json:"amount"`
+}
+
+type NftBalance struct {
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"contractType"`
+	Name            string       `json:"name"`
+	Symbol          string       `json:"symbol"`
+	TokenId         string       `json:"tokenId"`
+	TokenCount      units.Amount `json:"tokenCount"`
+}
+
+type TokenBalance struct {
+	ContractAddress string       `json:"contractAddress"`
+	Balance         units.Amount `json:"balance"`
+}
+
+type HTTPError struct {
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP error! status: %d", e.StatusCode)
+}
+
+type APIError struct {
+	Code int
+	Msg  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error! code: %d, message: %s", e.Code, e.Msg)
+}
+
+// DecodeError is returned when a response body cannot be decoded, including
+// failures reported by custom unmarshalers such as units.Amount.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("error unmarshalling response: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// apiNotFoundCode is the OAPI error code for unknown resources.
+const apiNotFoundCode = 404
+
+// isNotFound reports whether err means the requested resource does not
+// exist, as opposed to a transient or transport failure.
+func isNotFound(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == apiNotFoundCode
+}
+
 func fetchApi[T any](urlStr string) (*ApiResponse[T], error) {
 	req, err := http.NewRequest("GET", urlStr, nil)
 	if err != nil {
@@ -69,29 +456,41 @@ func fetchApi[T any](urlStr string) (*ApiResponse[T], error) {
 	}
 	req.Header.Add("Content-Type", "application/json")
 
+	start := time.Now()
+	status, body, err := doRequest(req)
+	duration := time.Since(start)
+
+	var apiResponse ApiResponse[T]
+	if err == nil {
+		if unmarshalErr := json.Unmarshal(body, &apiResponse); unmarshalErr != nil {
+			err = &DecodeError{Err: unmarshalErr}
+		} else if apiResponse.Code != 0 {
+			err = &APIError{Code: apiResponse.Code, Msg: apiResponse.Msg}
+		}
+	}
+	observeRequest(req, status, len(body), duration, err)
+	if err != nil {
+		return nil, err
+	}
+	return &apiResponse, nil
+}
+
+func doRequest(req *http.Request) (int, []byte, error) {
 	response, err := httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
+		return 0, nil, fmt.Errorf("error making request: %w", err)
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error! status: %d", response.StatusCode)
+		return response.StatusCode, nil, &HTTPError{StatusCode: response.StatusCode}
 	}
 
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
-	}
-
-	var apiResponse ApiResponse[T]
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+		return response.StatusCode, body, fmt.Errorf("error reading response body: %w", err)
 	}
-	if apiResponse.Code != 0 {
-		return nil, fmt.Errorf("API error! code: %d, message: %s", apiResponse.Code, apiResponse.Msg)
-	}
-	return &apiResponse, nil
+	return response.StatusCode, body, nil
 }
 
 func GetAccountKeyHistories(accountAddress string, page int, size int) (*ApiResponse[any], error) {
@@ -108,7 +507,7 @@ func GetAccountKeyHistories(accountAddress string, page int, size int) (*ApiResp
 
 	encodedAddress := url.PathEscape(accountAddress)
 
-	urlStr := fmt.Sprintf("%s/%s/%s/key-histories?%s", BASE_URL, accountEndpoint, encodedAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/key-histories?%s", BASE_URL, accountEndpoint, encodedAddress, queryParams.Encode())
 
 	return fetchApi[any](urlStr)
 }
@@ -121,34 +520,34 @@ func GetFungibleToken(tokenAddress Address) (*ApiResponse[TokenInfo], error) {
 	return fetchApi[TokenInfo](urlStr)
 }
 
-func GetNftItem(nftAddress Address, tokenId string) (*ApiResponse[any], error) {
+func GetNftItem(nftAddress Address, tokenId string) (*ApiResponse[NftItem], error) {
 	params := url.Values{}
 	params.Add("nftAddress", string(nftAddress))
 	params.Add("tokenId", tokenId)
 
 	urlStr := fmt.Sprintf("%s%s?%s", BASE_URL, nftsEndpoint, params.Encode())
-	return fetchApi[any](urlStr)
+	return fetchApi[NftItem](urlStr)
 }
 
-func GetContractCreationCode(contractAddress Address) (*ApiResponse[any], error) {
+func GetContractCreationCode(contractAddress Address) (*ApiResponse[ContractCreationCode], error) {
 	params := url.Values{}
 	params.Add("contractAddress", string(contractAddress))
 
 	urlStr := fmt.Sprintf("%s%s/creation-code?%s", BASE_URL, contractEndpoint, params.Encode())
-	return fetchApi[any](urlStr)
+	return fetchApi[ContractCreationCode](urlStr)
 }
 
-func GetContractSourceCode(contractAddress Address) (*ApiResponse[any], error) {
+func GetContractSourceCode(contractAddress Address) (*ApiResponse[ContractSourceCode], error) {
 	params := url.Values{}
 	params.Add("contractAddress", string(contractAddress))
 
 	urlStr := fmt.Sprintf("%s%s/source-code?%s", BASE_URL, contractEndpoint, params.Encode())
-	return fetchApi[any](urlStr)
+	return fetchApi[ContractSourceCode](urlStr)
 }
 
-func GetLatestBlock() (*ApiResponse[any], error) {
+func GetLatestBlock() (*ApiResponse[Block], error) {
 	urlStr := fmt.Sprintf("%s%s/latest", BASE_URL, blocksEndpoint)
-	return fetchApi[any](urlStr)
+	return fetchApi[Block](urlStr)
 }
 
 func GetLatestBlockBurns(page int, size int) (*ApiResponse[any], error) {
@@ -174,12 +573,12 @@ func GetLatestBlockRewards(blockNumber int) (*ApiResponse[any], error) {
 	return fetchApi[any](urlStr)
 }
 
-func GetBlock(blockNumber int64) (*ApiResponse[any], error) {
+func GetBlock(blockNumber int64) (*ApiResponse[Block], error) {
 	params := url.Values{}
 	params.Add("blockNumber", fmt.Sprintf("%d", blockNumber))
 
 	urlStr := fmt.Sprintf("%s%s?%s", BASE_URL, blocksEndpoint, params.Encode())
-	return fetchApi[any](urlStr)
+	return fetchApi[Block](urlStr)
 }
 
 func GetBlocks(
@@ -239,7 +638,7 @@ func GetInternalTransactionsOfBlock(blockNumber int, page int, size int) (*ApiRe
 	return fetchApi[any](urlStr)
 }
 
-func GetTransactionsOfBlock(blockNumber int, transactionType *string, page int, size int) (*ApiResponse[any], error) {
+func GetTransactionsOfBlock(blockNumber int, transactionType *string, page int, size int) (*ApiResponse[Page[Transaction]], error) {
 	queryParams := url.Values{}
 
 	if transactionType != nil {
@@ -256,12 +655,12 @@ func GetTransactionsOfBlock(blockNumber int, transactionType *string, page int,
 
 	urlStr := fmt.Sprintf("%s%s/%d/transactions?%s", BASE_URL, blocksEndpoint, blockNumber, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[Transaction]](urlStr)
 }
 
-func GetTransaction(transactionHash string) (*ApiResponse[any], error) {
+func GetTransaction(transactionHash string) (*ApiResponse[Transaction], error) {
 	urlStr := fmt.Sprintf("%s%s/%s", BASE_URL, transactionEndpoint, transactionHash)
-	return fetchApi[any](urlStr)
+	return fetchApi[Transaction](urlStr)
 }
 
 func GetTransactionReceiptStatus(transactionHash string) (*ApiResponse[any], error) {
@@ -283,7 +682,7 @@ func GetTokenHolders(
 	page int,
 	size int,
 	holderAddress *string,
-) (*ApiResponse[any], error) {
+) (*ApiResponse[Page[TokenHolder]], error) {
 	queryParams := url.Values{}
 
 	if holderAddress != nil {
@@ -300,32 +699,32 @@ func GetTokenHolders(
 
 	encodedTokenAddress := url.PathEscape(tokenAddress)
 
-	urlStr := fmt.Sprintf("%s/%s/%sholders?%s", BASE_URL, tokensEndpoint, encodedTokenAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/holders?%s", BASE_URL, tokensEndpoint, encodedTokenAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenHolder]](urlStr)
 }
 
-func GetBlocksByTimestamp(timestamp int64) (*ApiResponse[any], error) {
+func GetBlocksByTimestamp(timestamp int64) (*ApiResponse[[]Block], error) {
 	if timestamp <= 0 {
 		return nil, fmt.Errorf("timestamp must be a positive integer")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/timestamps/%d", BASE_URL, blocksEndpoint, timestamp)
+	urlStr := fmt.Sprintf("%s%s/timestamps/%d", BASE_URL, blocksEndpoint, timestamp)
 
-	return fetchApi[any](urlStr)
+	return fetchApi[[]Block](urlStr)
 }
 
-func GetContractInfo(contractAddress string) (*ApiResponse[any], error) {
+func GetContractInfo(contractAddress string) (*ApiResponse[ContractInfo], error) {
 	if contractAddress == "" {
 		return nil, fmt.Errorf("contract address is required")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s", BASE_URL, contractEndpoint, contractAddress)
+	urlStr := fmt.Sprintf("%s%s/%s", BASE_URL, contractEndpoint, contractAddress)
 
-	return fetchApi[any](urlStr)
+	return fetchApi[ContractInfo](urlStr)
 }
 
-func GetContractsInfo(contractAddresses []string) (*ApiResponse[any], error) {
+func GetContractsInfo(contractAddresses []string) (*ApiResponse[[]ContractInfo], error) {
 	if len(contractAddresses) == 0 {
 		return nil, fmt.Errorf("contract address list is required")
 	}
@@ -335,29 +734,29 @@ func GetContractsInfo(contractAddresses []string) (*ApiResponse[any], error) {
 	queryParams := url.Values{}
 	queryParams.Add("contractAddresses", contractAddressesStr)
 
-	urlStr := fmt.Sprintf("%s/%s?%s", BASE_URL, contractEndpoint, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s?%s", BASE_URL, contractEndpoint, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[[]ContractInfo](urlStr)
 }
 
-func GetContractAbi(contractAddress string) (*ApiResponse[any], error) {
+func GetContractAbi(contractAddress string) (*ApiResponse[ContractAbi], error) {
 	if contractAddress == "" {
 		return nil, fmt.Errorf("contract address is required")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/abi", BASE_URL, contractEndpoint, contractAddress)
+	urlStr := fmt.Sprintf("%s%s/%s/abi", BASE_URL, contractEndpoint, contractAddress)
 
-	return fetchApi[any](urlStr)
+	return fetchApi[ContractAbi](urlStr)
 }
 
-func GetNftInfo(tokenAddress string) (*ApiResponse[any], error) {
+func GetNftInfo(tokenAddress string) (*ApiResponse[NftCollection], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s", BASE_URL, nftsEndpoint, tokenAddress)
+	urlStr := fmt.Sprintf("%s%s/%s", BASE_URL, nftsEndpoint, tokenAddress)
 
-	return fetchApi[any](urlStr)
+	return fetchApi[NftCollection](urlStr)
 }
 
 func GetNftHolders(
@@ -365,7 +764,7 @@ func GetNftHolders(
 	page int,
 	size int,
 	tokenId *string,
-) (*ApiResponse[any], error) {
+) (*ApiResponse[Page[NftHolder]], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
@@ -386,9 +785,9 @@ func GetNftHolders(
 
 	encodedTokenAddress := url.PathEscape(tokenAddress)
 
-	urlStr := fmt.Sprintf("%s/%s/%s/holders?%s", BASE_URL, nftsEndpoint, encodedTokenAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/holders?%s", BASE_URL, nftsEndpoint, encodedTokenAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftHolder]](urlStr)
 }
 
 func GetNftTransfers(
@@ -398,7 +797,7 @@ func GetNftTransfers(
 	tokenId *string,
 	blockNumberStart *int,
 	blockNumberEnd *int,
-) (*ApiResponse[any], error) {
+) (*ApiResponse[Page[NftTransfer]], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
@@ -425,12 +824,12 @@ func GetNftTransfers(
 
 	encodedTokenAddress := url.PathEscape(tokenAddress)
 
-	urlStr := fmt.Sprintf("%s/%s/%s/transfers?%s", BASE_URL, nftsEndpoint, encodedTokenAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/transfers?%s", BASE_URL, nftsEndpoint, encodedTokenAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftTransfer]](urlStr)
 }
 
-func GetNftInventories(tokenAddress string, page int, size int, keyword *string) (*ApiResponse[any], error) {
+func GetNftInventories(tokenAddress string, page int, size int, keyword *string) (*ApiResponse[Page[NftInventoryEntry]], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
@@ -447,15 +846,15 @@ func GetNftInventories(tokenAddress string, page int, size int, keyword *string)
 	}
 
 	if keyword != nil {
-		queryParams = append(queryParams, fmt.Sprintf("keyword=%s", *keyword))
+		queryParams = append(queryParams, fmt.Sprintf("keyword=%s", url.QueryEscape(*keyword)))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/inventories?%s", BASE_URL, nftsEndpoint, tokenAddress, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/inventories?%s", BASE_URL, nftsEndpoint, tokenAddress, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftInventoryEntry]](urlStr)
 }
 
-func GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[any], error) {
+func GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenBurn]], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
@@ -478,12 +877,12 @@ func GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *in
 		queryParams = append(queryParams, fmt.Sprintf("blockNumberEnd=%d", *blockNumberEnd))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/burns?%s", BASE_URL, tokensEndpoint, tokenAddress, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/burns?%s", BASE_URL, tokensEndpoint, tokenAddress, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenBurn]](urlStr)
 }
 
-func GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[any], error) {
+func GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error) {
 	if tokenAddress == "" {
 		return nil, fmt.Errorf("token address is required")
 	}
@@ -506,9 +905,9 @@ func GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart
 		queryParams = append(queryParams, fmt.Sprintf("blockNumberEnd=%d", *blockNumberEnd))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/transfers?%s", BASE_URL, accountEndpoint, tokenAddress, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/transfers?%s", BASE_URL, tokensEndpoint, tokenAddress, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenTransfer]](urlStr)
 }
 
 func GetTransactionInputData(transactionHash string) (*ApiResponse[any], error) {
@@ -516,12 +915,12 @@ func GetTransactionInputData(transactionHash string) (*ApiResponse[any], error)
 		return nil, fmt.Errorf("transaction hash is required")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/input-data", BASE_URL, transactionEndpoint, transactionHash)
+	urlStr := fmt.Sprintf("%s%s/%s/input-data", BASE_URL, transactionEndpoint, transactionHash)
 
 	return fetchApi[any](urlStr)
 }
 
-func GetTransactionEventLogs(transactionHash string, page int, size int, signature *string) (*ApiResponse[any], error) {
+func GetTransactionEventLogs(transactionHash string, page int, size int, signature *string) (*ApiResponse[Page[EventLog]], error) {
 	if transactionHash == "" {
 		return nil, fmt.Errorf("transaction hash is required")
 	}
@@ -541,9 +940,9 @@ func GetTransactionEventLogs(transactionHash string, page int, size int, signatu
 		queryParams = append(queryParams, fmt.Sprintf("signature=%s", *signature))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/event-logs?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/event-logs?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[EventLog]](urlStr)
 }
 
 func GetTransactionInternalTransactions(transactionHash string, page int, size int) (*ApiResponse[any], error) {
@@ -562,12 +961,12 @@ func GetTransactionInternalTransactions(transactionHash string, page int, size i
 		fmt.Sprintf("size=%d", size),
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/internal-transactions?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/internal-transactions?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
 
 	return fetchApi[any](urlStr)
 }
 
-func GetTransactionTokenTransfers(transactionHash string, page int, size int) (*ApiResponse[any], error) {
+func GetTransactionTokenTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[TokenTransfer]], error) {
 	if transactionHash == "" {
 		return nil, fmt.Errorf("transaction hash is required")
 	}
@@ -583,12 +982,12 @@ func GetTransactionTokenTransfers(transactionHash string, page int, size int) (*
 		fmt.Sprintf("size=%d", size),
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/token-transfers?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/token-transfers?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenTransfer]](urlStr)
 }
 
-func GetTransactionNftTransfers(transactionHash string, page int, size int) (*ApiResponse[any], error) {
+func GetTransactionNftTransfers(transactionHash string, page int, size int) (*ApiResponse[Page[NftTransfer]], error) {
 	if transactionHash == "" {
 		return nil, fmt.Errorf("transaction hash is required")
 	}
@@ -604,12 +1003,12 @@ func GetTransactionNftTransfers(transactionHash string, page int, size int) (*Ap
 		fmt.Sprintf("size=%d", size),
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/nft-transfers?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
+	urlStr := fmt.Sprintf("%s%s/%s/nft-transfers?%s", BASE_URL, transactionEndpoint, transactionHash, strings.Join(queryParams, "&"))
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftTransfer]](urlStr)
 }
 
-func GetAccountTokenBalances(accountAddress string, page int, size int) (*ApiResponse[any], error) {
+func GetAccountTokenBalances(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -624,12 +1023,12 @@ func GetAccountTokenBalances(accountAddress string, page int, size int) (*ApiRes
 	queryParams.Add("page", fmt.Sprintf("%d", page))
 	queryParams.Add("size", fmt.Sprintf("%d", size))
 
-	urlStr := fmt.Sprintf("%s/%s/%s/token-balances?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/token-balances?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenBalance]](urlStr)
 }
 
-func GetAccountNftTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[any], error) {
+func GetAccountNftTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[NftTransfer]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -654,12 +1053,12 @@ func GetAccountNftTransfers(accountAddress string, page int, size int, contractA
 		queryParams.Add("blockNumberEnd", fmt.Sprintf("%d", *blockNumberEnd))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/nft-transfers?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/nft-transfers?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftTransfer]](urlStr)
 }
 
-func GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*ApiResponse[any], error) {
+func GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -674,12 +1073,12 @@ func GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*Api
 	queryParams.Add("page", fmt.Sprintf("%d", page))
 	queryParams.Add("size", fmt.Sprintf("%d", size))
 
-	urlStr := fmt.Sprintf("%s/%s/%s/nft-balances/kip37?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/nft-balances/kip37?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftBalance]](urlStr)
 }
 
-func GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*ApiResponse[any], error) {
+func GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*ApiResponse[Page[NftBalance]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -694,12 +1093,12 @@ func GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*Api
 	queryParams.Add("page", fmt.Sprintf("%d", page))
 	queryParams.Add("size", fmt.Sprintf("%d", size))
 
-	urlStr := fmt.Sprintf("%s/%s/%s/nft-balances/kip17?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/nft-balances/kip17?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[NftBalance]](urlStr)
 }
 
-func GetAccountEventLogs(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[any], error) {
+func GetAccountEventLogs(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[EventLog]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -724,12 +1123,12 @@ func GetAccountEventLogs(accountAddress string, page int, size int, signature *s
 		queryParams.Add("blockNumberEnd", fmt.Sprintf("%d", *blockNumberEnd))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/event-logs?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/event-logs?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[EventLog]](urlStr)
 }
 
-func GetAccountTokenTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[any], error) {
+func GetAccountTokenTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*ApiResponse[Page[TokenTransfer]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -754,22 +1153,22 @@ func GetAccountTokenTransfers(accountAddress string, page int, size int, contrac
 		queryParams.Add("blockNumberEnd", fmt.Sprintf("%d", *blockNumberEnd))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/token-transfers?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/token-transfers?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenTransfer]](urlStr)
 }
 
-func GetAccountInfo(accountAddress string) (*ApiResponse[any], error) {
+func GetAccountInfo(accountAddress string) (*ApiResponse[AccountInfo], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s", BASE_URL, accountEndpoint, accountAddress)
+	urlStr := fmt.Sprintf("%s%s/%s", BASE_URL, accountEndpoint, accountAddress)
 
-	return fetchApi[any](urlStr)
+	return fetchApi[AccountInfo](urlStr)
 }
 
-func GetFeePaidTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*ApiResponse[any], error) {
+func GetFeePaidTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*ApiResponse[Page[Transaction]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -794,12 +1193,12 @@ func GetFeePaidTransactions(accountAddress string, page int, size int, blockNumb
 		queryParams.Add("type", *txType)
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/fee-paid-transactions?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/fee-paid-transactions?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[Transaction]](urlStr)
 }
 
-func GetAccountTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*ApiResponse[any], error) {
+func GetAccountTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*ApiResponse[Page[Transaction]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -827,12 +1226,12 @@ func GetAccountTransactions(accountAddress string, page int, size int, blockNumb
 		queryParams.Add("directions", strings.Join(directions, ","))
 	}
 
-	urlStr := fmt.Sprintf("%s/%s/%s/transactions?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/transactions?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[Transaction]](urlStr)
 }
 
-func GetAccountTokenDetails(accountAddress string, page int, size int) (*ApiResponse[any], error) {
+func GetAccountTokenDetails(accountAddress string, page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
 	if accountAddress == "" {
 		return nil, fmt.Errorf("account address is required")
 	}
@@ -847,7 +1246,7 @@ func GetAccountTokenDetails(accountAddress string, page int, size int) (*ApiResp
 	queryParams.Add("page", fmt.Sprintf("%d", page))
 	queryParams.Add("size", fmt.Sprintf("%d", size))
 
-	urlStr := fmt.Sprintf("%s/%s/%s/token-details?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
+	urlStr := fmt.Sprintf("%s%s/%s/token-details?%s", BASE_URL, accountEndpoint, accountAddress, queryParams.Encode())
 
-	return fetchApi[any](urlStr)
+	return fetchApi[Page[TokenBalance]](urlStr)
 }
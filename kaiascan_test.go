@@ -2,10 +2,13 @@ package kaiascan
 
 import (
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"kaiascan.go/units"
 )
 
 func TestConfigureSDK(t *testing.T) {
@@ -86,3 +89,137 @@ func TestGetFungibleToken_Error(t *testing.T) {
 	}
 	log.Printf("Error Response: %v", err)
 }
+
+func TestGetTokenTransfers(t *testing.T) {
+	mockResponse := []byte(`{"code":0,"msg":"Success","data":{"paging":{"totalCount":1,"currentPage":1,"last":true,"totalPage":1},
+		"results":[{"transactionHash":"0xabc","logIndex":3,"blockId":100,"from":"0x1","to":"0x2","contractAddress":"0xtoken",
+		"amount":"123456789012345678901234"}]}}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tokens/0xtoken/transfers" {
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+		w.Write(mockResponse)
+	}))
+	defer server.Close()
+
+	BASE_URL = server.URL + "/"
+
+	resp, err := GetTokenTransfers("0xtoken", 1, 20, nil, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.Data.Results) != 1 {
+		t.Fatalf("Expected 1 transfer, got %d", len(resp.Data.Results))
+	}
+	transfer := resp.Data.Results[0]
+	if transfer.Amount.String() != "123456789012345678901234" || transfer.LogIndex != 3 || transfer.BlockNumber != 100 {
+		t.Errorf("Unexpected transfer: %+v", transfer)
+	}
+}
+
+func TestGetTokenTransfers_FractionalAmount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":0,"msg":"Success","data":{"paging":{"last":true},"results":[{"amount":"1.5"}]}}`))
+	}))
+	defer server.Close()
+
+	BASE_URL = server.URL + "/"
+
+	_, err := GetTokenTransfers("0xtoken", 1, 20, nil, nil)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || !errors.Is(err, units.ErrFractionalAmount) {
+		t.Errorf("Expected a DecodeError wrapping ErrFractionalAmount, got %v", err)
+	}
+}
+
+func TestGetTransactionNftTransfers(t *testing.T) {
+	mockResponse := []byte(`{"code":0,"msg":"Success","data":{"paging":{"totalCount":2,"currentPage":1,"last":true,"totalPage":1},
+		"results":[{"transactionHash":"0xabc","contractType":"KIP-17","tokenId":"7"},
+		{"transactionHash":"0xabc","contractType":"KIP37","tokenId":"8","tokenCount":"25"}]}}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(mockResponse)
+	}))
+	defer server.Close()
+
+	BASE_URL = server.URL + "/"
+
+	resp, err := GetTransactionNftTransfers("0xabc", 1, 20)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	transfers := resp.Data.Results
+	if transfers[0].Kind != NftKindKIP17 || transfers[1].Kind != NftKindKIP37 {
+		t.Errorf("Unexpected NFT kinds: %s, %s", transfers[0].Kind, transfers[1].Kind)
+	}
+	if transfers[1].Quantity.String() != "25" {
+		t.Errorf("Expected quantity 25, got %s", transfers[1].Quantity)
+	}
+}
+
+func TestGetNftItem(t *testing.T) {
+	mockResponse := []byte(`{"code":0,"msg":"Success","data":{"contractAddress":"0xnft","contractType":"KIP17","tokenId":"42",
+		"owner":"0xowner","tokenUri":"ipfs://QmHash/42","totalSupply":"1",
+		"metadata":"{\"name\":\"Item #42\",\"image\":\"ipfs://QmImage\",\"attributes\":[{\"trait_type\":\"Level\",\"value\":7}]}"}}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/nfts" || r.URL.Query().Get("tokenId") != "42" {
+			t.Fatalf("Unexpected API request: %s", r.URL.String())
+		}
+		w.Write(mockResponse)
+	}))
+	defer server.Close()
+
+	BASE_URL = server.URL + "/"
+
+	resp, err := GetNftItem("0xnft", "42")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	item := resp.Data
+	if item.Kind != NftKindKIP17 || item.Owner != "0xowner" || item.TotalSupply.String() != "1" {
+		t.Errorf("Unexpected item: %+v", item)
+	}
+	if item.Metadata == nil || item.Metadata.Name != "Item #42" || len(item.Metadata.Attributes) != 1 {
+		t.Fatalf("Unexpected metadata: %+v", item.Metadata)
+	}
+	if item.Metadata.Attributes[0].TraitType != "Level" {
+		t.Errorf("Unexpected attribute: %+v", item.Metadata.Attributes[0])
+	}
+}
+
+func TestNftMetadata_Malformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		check func(m NftMetadata) bool
+	}{
+		{"numeric name", `{"name":42,"image":"ipfs://QmImage"}`, func(m NftMetadata) bool {
+			return m.Name == "42" && m.Image == "ipfs://QmImage" && len(m.Raw) > 0
+		}},
+		{"non-JSON text", `"not json at all"`, func(m NftMetadata) bool {
+			return m.Name == "" && string(m.Raw) == `"not json at all"`
+		}},
+		{"attributes object", `{"name":"Item","attributes":{"Level":7,"Color":"red"}}`, func(m NftMetadata) bool {
+			return m.Name == "Item" && len(m.Attributes) == 2 && m.Attributes[0].TraitType == "Color" && len(m.Raw) > 0
+		}},
+		{"well-formed", `{"name":"Item","attributes":[{"trait_type":"Level","value":7}]}`, func(m NftMetadata) bool {
+			return m.Name == "Item" && len(m.Attributes) == 1 && m.Raw == nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var item NftItem
+			if err := json.Unmarshal([]byte(`{"tokenId":"1","metadata":`+tt.input+`}`), &item); err != nil {
+				t.Fatalf("Expected lenient decoding, got %v", err)
+			}
+			if item.Metadata == nil || !tt.check(*item.Metadata) {
+				t.Errorf("Unexpected metadata: %+v", item.Metadata)
+			}
+			if _, err := json.Marshal(item); err != nil {
+				t.Errorf("Expected metadata to marshal, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package kaiascantest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	kaiascan "kaiascan.go"
+)
+
+const redacted = "REDACTED"
+
+type Mode int
+
+const (
+	// Replay serves responses from the cassette and fails requests it has
+	// no recording for.
+	Replay Mode = iota
+	// Record forwards requests and records every exchange.
+	Record
+)
+
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+}
+
+type RecordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records exchanges to a cassette file
+// or replays them. Requests match on method, path and query parameters, so a
+// cassette recorded against mainnet replays against any base URL. Header and
+// query values that may carry credentials are redacted before they are
+// stored and ignored when matching.
+type Recorder struct {
+	Path string
+	Mode Mode
+	// Transport performs requests in Record mode. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+	// RedactHeaders and RedactParams are matched case-insensitively.
+	RedactHeaders []string
+	RedactParams  []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		Path:          path,
+		Mode:          mode,
+		RedactHeaders: []string{"Authorization", "Proxy-Authorization", "X-Api-Key", "Api-Key", "Cookie"},
+		RedactParams:  []string{"apikey", "api_key", "key", "token", "access_token"},
+	}
+	if mode == Record {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("cassette %s not found; record it first", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.Mode == Record {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	headers := resp.Header.Clone()
+	headers.Del("Set-Cookie")
+	headers.Del("Date")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     r.redactURL(req.URL),
+			Headers: r.redactHeaders(req.Header),
+		},
+		Response: RecordedResponse{Status: resp.StatusCode, Headers: headers, Body: string(body)},
+	})
+	r.used = append(r.used, true)
+	return resp, nil
+}
+
+// replay serves the first unused matching interaction, falling back to the
+// last matching one so that repeated requests such as polling keep working.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := r.matchKey(req.Method, r.redactURL(req.URL))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match := -1
+	for i, in := range r.cassette.Interactions {
+		u, err := url.Parse(in.Request.URL)
+		if err != nil || r.matchKey(in.Request.Method, r.redactURL(u)) != key {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("cassette %s has no recording for %s %s", r.Path, req.Method, r.redactURL(req.URL))
+	}
+	r.used[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Save writes the recorded exchanges to Path. It does nothing in Replay
+// mode.
+func (r *Recorder) Save() error {
+	if r.Mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0o755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.Path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("error writing cassette: %w", err)
+	}
+	return nil
+}
+
+// Unused lists the recorded requests that have not been replayed.
+func (r *Recorder) Unused() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []string
+	for i, in := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, in.Request.Method+" "+in.Request.URL)
+		}
+	}
+	return unused
+}
+
+func (r *Recorder) matchKey(method string, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return method + " " + rawURL
+	}
+	// Credentials do not take part in matching; url.Values.Encode sorts
+	// the remaining parameters.
+	q := u.Query()
+	for name := range q {
+		if containsFold(r.RedactParams, name) {
+			q.Del(name)
+		}
+	}
+	return method + " " + normalizePath(u.Path) + "?" + q.Encode()
+}
+
+func (r *Recorder) redactURL(u *url.URL) string {
+	redactedURL := *u
+	q := u.Query()
+	for name := range q {
+		if containsFold(r.RedactParams, name) {
+			q[name] = []string{redacted}
+		}
+	}
+	redactedURL.RawQuery = q.Encode()
+	redactedURL.User = nil
+	return redactedURL.String()
+}
+
+func (r *Recorder) redactHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	headers := h.Clone()
+	for name := range headers {
+		if containsFold(r.RedactHeaders, name) {
+			headers[name] = []string{redacted}
+		}
+	}
+	return headers
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// UseCassette routes the SDK through a Recorder for the rest of the test. It
+// records to path when the KAIASCAN_RECORD environment variable is set and
+// replays otherwise, failing the test if any recording went unused.
+func UseCassette(t testing.TB, path string) *Recorder {
+	t.Helper()
+	mode := Replay
+	if os.Getenv("KAIASCAN_RECORD") != "" {
+		mode = Record
+	}
+	r, err := NewRecorder(path, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kaiascan.ConfigureTransport(r)
+	t.Cleanup(func() {
+		kaiascan.ConfigureTransport(nil)
+		if err := r.Save(); err != nil {
+			t.Error(err)
+		}
+		if unused := r.Unused(); len(unused) > 0 && !t.Failed() {
+			t.Errorf("cassette %s has unused recordings: %v", path, unused)
+		}
+	})
+	return r
+}
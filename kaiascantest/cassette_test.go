@@ -0,0 +1,86 @@
+package kaiascantest
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kaiascan "kaiascan.go"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "blocks.json")
+
+	server := NewServer(DefaultFixtures())
+	recorder, err := NewRecorder(path, Record)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := &http.Client{Transport: recorder}
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/blocks/latest?apiKey=secret-key", nil)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	resp, err := client.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected recorded response: %v, %v", resp, err)
+	}
+	resp.Body.Close()
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret") || !strings.Contains(string(data), redacted) {
+		t.Errorf("Expected credentials to be redacted:\n%s", data)
+	}
+
+	replayer, err := NewRecorder(path, Replay)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	kaiascan.ConfigureTransport(replayer)
+	defer kaiascan.ConfigureTransport(nil)
+	previous := kaiascan.BASE_URL
+	kaiascan.BASE_URL = "https://replay.invalid/"
+	defer func() { kaiascan.BASE_URL = previous }()
+
+	latest, err := kaiascan.GetLatestBlock()
+	if err != nil || latest.Data.BlockNumber != 10 {
+		t.Fatalf("Unexpected replayed block: %+v, %v", latest, err)
+	}
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("Expected every recording to be used, got %v", unused)
+	}
+
+	_, err = kaiascan.GetBlock(3)
+	if err == nil || !strings.Contains(err.Error(), "no recording for GET") {
+		t.Errorf("Expected an unmatched request to fail, got %v", err)
+	}
+}
+
+func TestUseCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx.json")
+	cassette := `{"interactions":[
+		{"request":{"method":"GET","url":"https://mainnet-oapi.kaiascan.io//api/v1/transactions/0xabc"},
+		 "response":{"status":200,"body":"{\"code\":0,\"msg\":\"Success\",\"data\":{\"transactionHash\":\"0xabc\",\"blockId\":7}}"}}
+	]}`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KAIASCAN_RECORD", "")
+
+	UseCassette(t, path)
+	tx, err := kaiascan.GetTransaction("0xabc")
+	if err != nil || tx.Data.BlockNumber != 7 {
+		t.Errorf("Unexpected replayed transaction: %+v, %v", tx, err)
+	}
+
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), Replay); err == nil {
+		t.Error("Expected a missing cassette to fail in replay mode")
+	}
+}
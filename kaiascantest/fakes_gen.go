@@ -0,0 +1,2069 @@
+// Code generated by genfakes from client.go. DO NOT EDIT.
+
+package kaiascantest
+
+import (
+	"sync"
+
+	kaiascan "kaiascan.go"
+)
+
+// FakeAPI implements kaiascan.API with one fake per domain.
+type FakeAPI struct {
+	FakeBlocksAPI
+	FakeTransactionsAPI
+	FakeAccountsAPI
+	FakeTokensAPI
+	FakeNftsAPI
+	FakeContractsAPI
+}
+
+var _ kaiascan.API = (*FakeAPI)(nil)
+
+type FakeBlocksAPI struct {
+	mu sync.Mutex
+
+	GetLatestBlockStub    func() (*kaiascan.ApiResponse[kaiascan.Block], error)
+	getLatestBlockArgs    []struct{}
+	getLatestBlockReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Block]
+		result2 error
+	}
+
+	GetBlockStub    func(blockNumber int64) (*kaiascan.ApiResponse[kaiascan.Block], error)
+	getBlockArgs    []struct{ blockNumber int64 }
+	getBlockReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Block]
+		result2 error
+	}
+
+	GetBlocksStub func(blockNumber int, blockNumberStart *int, blockNumberEnd *int, page int, size int) (*kaiascan.ApiResponse[any], error)
+	getBlocksArgs []struct {
+		blockNumber      int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		page             int
+		size             int
+	}
+	getBlocksReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetBlocksByTimestampStub    func(timestamp int64) (*kaiascan.ApiResponse[[]kaiascan.Block], error)
+	getBlocksByTimestampArgs    []struct{ timestamp int64 }
+	getBlocksByTimestampReturns struct {
+		result1 *kaiascan.ApiResponse[[]kaiascan.Block]
+		result2 error
+	}
+
+	GetLatestBlockBurnsStub func(page int, size int) (*kaiascan.ApiResponse[any], error)
+	getLatestBlockBurnsArgs []struct {
+		page int
+		size int
+	}
+	getLatestBlockBurnsReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetLatestBlockRewardsStub    func(blockNumber int) (*kaiascan.ApiResponse[any], error)
+	getLatestBlockRewardsArgs    []struct{ blockNumber int }
+	getLatestBlockRewardsReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetBlockBurnsStub    func(blockNumber int) (*kaiascan.ApiResponse[any], error)
+	getBlockBurnsArgs    []struct{ blockNumber int }
+	getBlockBurnsReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetBlockRewardsStub    func(blockNumber int) (*kaiascan.ApiResponse[any], error)
+	getBlockRewardsArgs    []struct{ blockNumber int }
+	getBlockRewardsReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetTransactionsOfBlockStub func(blockNumber int, transactionType *string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error)
+	getTransactionsOfBlockArgs []struct {
+		blockNumber     int
+		transactionType *string
+		page            int
+		size            int
+	}
+	getTransactionsOfBlockReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}
+
+	GetInternalTransactionsOfBlockStub func(blockNumber int, page int, size int) (*kaiascan.ApiResponse[any], error)
+	getInternalTransactionsOfBlockArgs []struct {
+		blockNumber int
+		page        int
+		size        int
+	}
+	getInternalTransactionsOfBlockReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+}
+
+var _ kaiascan.BlocksAPI = (*FakeBlocksAPI)(nil)
+
+func (f *FakeBlocksAPI) GetLatestBlock() (*kaiascan.ApiResponse[kaiascan.Block], error) {
+	f.mu.Lock()
+	f.getLatestBlockArgs = append(f.getLatestBlockArgs, struct{}{})
+	stub, returns := f.GetLatestBlockStub, f.getLatestBlockReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getLatestBlockArgs)
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockReturns(result1 *kaiascan.ApiResponse[kaiascan.Block], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetLatestBlockStub = nil
+	f.getLatestBlockReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Block]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetBlock(blockNumber int64) (*kaiascan.ApiResponse[kaiascan.Block], error) {
+	f.mu.Lock()
+	f.getBlockArgs = append(f.getBlockArgs, struct{ blockNumber int64 }{blockNumber})
+	stub, returns := f.GetBlockStub, f.getBlockReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetBlockCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBlockArgs)
+}
+
+func (f *FakeBlocksAPI) GetBlockArgsForCall(i int) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getBlockArgs[i]
+	return args.blockNumber
+}
+
+func (f *FakeBlocksAPI) GetBlockReturns(result1 *kaiascan.ApiResponse[kaiascan.Block], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetBlockStub = nil
+	f.getBlockReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Block]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetBlocks(blockNumber int, blockNumberStart *int, blockNumberEnd *int, page int, size int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getBlocksArgs = append(f.getBlocksArgs, struct {
+		blockNumber      int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		page             int
+		size             int
+	}{blockNumber, blockNumberStart, blockNumberEnd, page, size})
+	stub, returns := f.GetBlocksStub, f.getBlocksReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber, blockNumberStart, blockNumberEnd, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetBlocksCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBlocksArgs)
+}
+
+func (f *FakeBlocksAPI) GetBlocksArgsForCall(i int) (int, *int, *int, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getBlocksArgs[i]
+	return args.blockNumber, args.blockNumberStart, args.blockNumberEnd, args.page, args.size
+}
+
+func (f *FakeBlocksAPI) GetBlocksReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetBlocksStub = nil
+	f.getBlocksReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetBlocksByTimestamp(timestamp int64) (*kaiascan.ApiResponse[[]kaiascan.Block], error) {
+	f.mu.Lock()
+	f.getBlocksByTimestampArgs = append(f.getBlocksByTimestampArgs, struct{ timestamp int64 }{timestamp})
+	stub, returns := f.GetBlocksByTimestampStub, f.getBlocksByTimestampReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(timestamp)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetBlocksByTimestampCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBlocksByTimestampArgs)
+}
+
+func (f *FakeBlocksAPI) GetBlocksByTimestampArgsForCall(i int) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getBlocksByTimestampArgs[i]
+	return args.timestamp
+}
+
+func (f *FakeBlocksAPI) GetBlocksByTimestampReturns(result1 *kaiascan.ApiResponse[[]kaiascan.Block], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetBlocksByTimestampStub = nil
+	f.getBlocksByTimestampReturns = struct {
+		result1 *kaiascan.ApiResponse[[]kaiascan.Block]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockBurns(page int, size int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getLatestBlockBurnsArgs = append(f.getLatestBlockBurnsArgs, struct {
+		page int
+		size int
+	}{page, size})
+	stub, returns := f.GetLatestBlockBurnsStub, f.getLatestBlockBurnsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockBurnsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getLatestBlockBurnsArgs)
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockBurnsArgsForCall(i int) (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getLatestBlockBurnsArgs[i]
+	return args.page, args.size
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockBurnsReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetLatestBlockBurnsStub = nil
+	f.getLatestBlockBurnsReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockRewards(blockNumber int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getLatestBlockRewardsArgs = append(f.getLatestBlockRewardsArgs, struct{ blockNumber int }{blockNumber})
+	stub, returns := f.GetLatestBlockRewardsStub, f.getLatestBlockRewardsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockRewardsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getLatestBlockRewardsArgs)
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockRewardsArgsForCall(i int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getLatestBlockRewardsArgs[i]
+	return args.blockNumber
+}
+
+func (f *FakeBlocksAPI) GetLatestBlockRewardsReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetLatestBlockRewardsStub = nil
+	f.getLatestBlockRewardsReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetBlockBurns(blockNumber int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getBlockBurnsArgs = append(f.getBlockBurnsArgs, struct{ blockNumber int }{blockNumber})
+	stub, returns := f.GetBlockBurnsStub, f.getBlockBurnsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetBlockBurnsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBlockBurnsArgs)
+}
+
+func (f *FakeBlocksAPI) GetBlockBurnsArgsForCall(i int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getBlockBurnsArgs[i]
+	return args.blockNumber
+}
+
+func (f *FakeBlocksAPI) GetBlockBurnsReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetBlockBurnsStub = nil
+	f.getBlockBurnsReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetBlockRewards(blockNumber int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getBlockRewardsArgs = append(f.getBlockRewardsArgs, struct{ blockNumber int }{blockNumber})
+	stub, returns := f.GetBlockRewardsStub, f.getBlockRewardsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetBlockRewardsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getBlockRewardsArgs)
+}
+
+func (f *FakeBlocksAPI) GetBlockRewardsArgsForCall(i int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getBlockRewardsArgs[i]
+	return args.blockNumber
+}
+
+func (f *FakeBlocksAPI) GetBlockRewardsReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetBlockRewardsStub = nil
+	f.getBlockRewardsReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetTransactionsOfBlock(blockNumber int, transactionType *string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error) {
+	f.mu.Lock()
+	f.getTransactionsOfBlockArgs = append(f.getTransactionsOfBlockArgs, struct {
+		blockNumber     int
+		transactionType *string
+		page            int
+		size            int
+	}{blockNumber, transactionType, page, size})
+	stub, returns := f.GetTransactionsOfBlockStub, f.getTransactionsOfBlockReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber, transactionType, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetTransactionsOfBlockCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionsOfBlockArgs)
+}
+
+func (f *FakeBlocksAPI) GetTransactionsOfBlockArgsForCall(i int) (int, *string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionsOfBlockArgs[i]
+	return args.blockNumber, args.transactionType, args.page, args.size
+}
+
+func (f *FakeBlocksAPI) GetTransactionsOfBlockReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionsOfBlockStub = nil
+	f.getTransactionsOfBlockReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeBlocksAPI) GetInternalTransactionsOfBlock(blockNumber int, page int, size int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getInternalTransactionsOfBlockArgs = append(f.getInternalTransactionsOfBlockArgs, struct {
+		blockNumber int
+		page        int
+		size        int
+	}{blockNumber, page, size})
+	stub, returns := f.GetInternalTransactionsOfBlockStub, f.getInternalTransactionsOfBlockReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(blockNumber, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeBlocksAPI) GetInternalTransactionsOfBlockCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getInternalTransactionsOfBlockArgs)
+}
+
+func (f *FakeBlocksAPI) GetInternalTransactionsOfBlockArgsForCall(i int) (int, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getInternalTransactionsOfBlockArgs[i]
+	return args.blockNumber, args.page, args.size
+}
+
+func (f *FakeBlocksAPI) GetInternalTransactionsOfBlockReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetInternalTransactionsOfBlockStub = nil
+	f.getInternalTransactionsOfBlockReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+type FakeTransactionsAPI struct {
+	mu sync.Mutex
+
+	GetTransactionStub    func(transactionHash string) (*kaiascan.ApiResponse[kaiascan.Transaction], error)
+	getTransactionArgs    []struct{ transactionHash string }
+	getTransactionReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Transaction]
+		result2 error
+	}
+
+	GetTransactionStatusStub    func(transactionHash string) (*kaiascan.ApiResponse[any], error)
+	getTransactionStatusArgs    []struct{ transactionHash string }
+	getTransactionStatusReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetTransactionReceiptStatusStub    func(transactionHash string) (*kaiascan.ApiResponse[any], error)
+	getTransactionReceiptStatusArgs    []struct{ transactionHash string }
+	getTransactionReceiptStatusReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetTransactionInputDataStub    func(transactionHash string) (*kaiascan.ApiResponse[any], error)
+	getTransactionInputDataArgs    []struct{ transactionHash string }
+	getTransactionInputDataReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetTransactionEventLogsStub func(transactionHash string, page int, size int, signature *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], error)
+	getTransactionEventLogsArgs []struct {
+		transactionHash string
+		page            int
+		size            int
+		signature       *string
+	}
+	getTransactionEventLogsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]]
+		result2 error
+	}
+
+	GetTransactionInternalTransactionsStub func(transactionHash string, page int, size int) (*kaiascan.ApiResponse[any], error)
+	getTransactionInternalTransactionsArgs []struct {
+		transactionHash string
+		page            int
+		size            int
+	}
+	getTransactionInternalTransactionsReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetTransactionTokenTransfersStub func(transactionHash string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error)
+	getTransactionTokenTransfersArgs []struct {
+		transactionHash string
+		page            int
+		size            int
+	}
+	getTransactionTokenTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}
+
+	GetTransactionNftTransfersStub func(transactionHash string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error)
+	getTransactionNftTransfersArgs []struct {
+		transactionHash string
+		page            int
+		size            int
+	}
+	getTransactionNftTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}
+}
+
+var _ kaiascan.TransactionsAPI = (*FakeTransactionsAPI)(nil)
+
+func (f *FakeTransactionsAPI) GetTransaction(transactionHash string) (*kaiascan.ApiResponse[kaiascan.Transaction], error) {
+	f.mu.Lock()
+	f.getTransactionArgs = append(f.getTransactionArgs, struct{ transactionHash string }{transactionHash})
+	stub, returns := f.GetTransactionStub, f.getTransactionReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionArgs[i]
+	return args.transactionHash
+}
+
+func (f *FakeTransactionsAPI) GetTransactionReturns(result1 *kaiascan.ApiResponse[kaiascan.Transaction], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionStub = nil
+	f.getTransactionReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Transaction]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionStatus(transactionHash string) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getTransactionStatusArgs = append(f.getTransactionStatusArgs, struct{ transactionHash string }{transactionHash})
+	stub, returns := f.GetTransactionStatusStub, f.getTransactionStatusReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionStatusCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionStatusArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionStatusArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionStatusArgs[i]
+	return args.transactionHash
+}
+
+func (f *FakeTransactionsAPI) GetTransactionStatusReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionStatusStub = nil
+	f.getTransactionStatusReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionReceiptStatus(transactionHash string) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getTransactionReceiptStatusArgs = append(f.getTransactionReceiptStatusArgs, struct{ transactionHash string }{transactionHash})
+	stub, returns := f.GetTransactionReceiptStatusStub, f.getTransactionReceiptStatusReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionReceiptStatusCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionReceiptStatusArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionReceiptStatusArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionReceiptStatusArgs[i]
+	return args.transactionHash
+}
+
+func (f *FakeTransactionsAPI) GetTransactionReceiptStatusReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionReceiptStatusStub = nil
+	f.getTransactionReceiptStatusReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInputData(transactionHash string) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getTransactionInputDataArgs = append(f.getTransactionInputDataArgs, struct{ transactionHash string }{transactionHash})
+	stub, returns := f.GetTransactionInputDataStub, f.getTransactionInputDataReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInputDataCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionInputDataArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInputDataArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionInputDataArgs[i]
+	return args.transactionHash
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInputDataReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionInputDataStub = nil
+	f.getTransactionInputDataReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionEventLogs(transactionHash string, page int, size int, signature *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], error) {
+	f.mu.Lock()
+	f.getTransactionEventLogsArgs = append(f.getTransactionEventLogsArgs, struct {
+		transactionHash string
+		page            int
+		size            int
+		signature       *string
+	}{transactionHash, page, size, signature})
+	stub, returns := f.GetTransactionEventLogsStub, f.getTransactionEventLogsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash, page, size, signature)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionEventLogsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionEventLogsArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionEventLogsArgsForCall(i int) (string, int, int, *string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionEventLogsArgs[i]
+	return args.transactionHash, args.page, args.size, args.signature
+}
+
+func (f *FakeTransactionsAPI) GetTransactionEventLogsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionEventLogsStub = nil
+	f.getTransactionEventLogsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInternalTransactions(transactionHash string, page int, size int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getTransactionInternalTransactionsArgs = append(f.getTransactionInternalTransactionsArgs, struct {
+		transactionHash string
+		page            int
+		size            int
+	}{transactionHash, page, size})
+	stub, returns := f.GetTransactionInternalTransactionsStub, f.getTransactionInternalTransactionsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInternalTransactionsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionInternalTransactionsArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInternalTransactionsArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionInternalTransactionsArgs[i]
+	return args.transactionHash, args.page, args.size
+}
+
+func (f *FakeTransactionsAPI) GetTransactionInternalTransactionsReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionInternalTransactionsStub = nil
+	f.getTransactionInternalTransactionsReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionTokenTransfers(transactionHash string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error) {
+	f.mu.Lock()
+	f.getTransactionTokenTransfersArgs = append(f.getTransactionTokenTransfersArgs, struct {
+		transactionHash string
+		page            int
+		size            int
+	}{transactionHash, page, size})
+	stub, returns := f.GetTransactionTokenTransfersStub, f.getTransactionTokenTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionTokenTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionTokenTransfersArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionTokenTransfersArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionTokenTransfersArgs[i]
+	return args.transactionHash, args.page, args.size
+}
+
+func (f *FakeTransactionsAPI) GetTransactionTokenTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionTokenTransfersStub = nil
+	f.getTransactionTokenTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTransactionsAPI) GetTransactionNftTransfers(transactionHash string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error) {
+	f.mu.Lock()
+	f.getTransactionNftTransfersArgs = append(f.getTransactionNftTransfersArgs, struct {
+		transactionHash string
+		page            int
+		size            int
+	}{transactionHash, page, size})
+	stub, returns := f.GetTransactionNftTransfersStub, f.getTransactionNftTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(transactionHash, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTransactionsAPI) GetTransactionNftTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTransactionNftTransfersArgs)
+}
+
+func (f *FakeTransactionsAPI) GetTransactionNftTransfersArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTransactionNftTransfersArgs[i]
+	return args.transactionHash, args.page, args.size
+}
+
+func (f *FakeTransactionsAPI) GetTransactionNftTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTransactionNftTransfersStub = nil
+	f.getTransactionNftTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+type FakeAccountsAPI struct {
+	mu sync.Mutex
+
+	GetAccountInfoStub    func(accountAddress string) (*kaiascan.ApiResponse[kaiascan.AccountInfo], error)
+	getAccountInfoArgs    []struct{ accountAddress string }
+	getAccountInfoReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.AccountInfo]
+		result2 error
+	}
+
+	GetAccountKeyHistoriesStub func(accountAddress string, page int, size int) (*kaiascan.ApiResponse[any], error)
+	getAccountKeyHistoriesArgs []struct {
+		accountAddress string
+		page           int
+		size           int
+	}
+	getAccountKeyHistoriesReturns struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}
+
+	GetAccountTransactionsStub func(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error)
+	getAccountTransactionsArgs []struct {
+		accountAddress   string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		txType           *string
+		directions       []string
+	}
+	getAccountTransactionsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}
+
+	GetFeePaidTransactionsStub func(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error)
+	getFeePaidTransactionsArgs []struct {
+		accountAddress   string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		txType           *string
+	}
+	getFeePaidTransactionsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}
+
+	GetAccountTokenBalancesStub func(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], error)
+	getAccountTokenBalancesArgs []struct {
+		accountAddress string
+		page           int
+		size           int
+	}
+	getAccountTokenBalancesReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]]
+		result2 error
+	}
+
+	GetAccountTokenDetailsStub func(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], error)
+	getAccountTokenDetailsArgs []struct {
+		accountAddress string
+		page           int
+		size           int
+	}
+	getAccountTokenDetailsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]]
+		result2 error
+	}
+
+	GetAccountTokenTransfersStub func(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error)
+	getAccountTokenTransfersArgs []struct {
+		accountAddress   string
+		page             int
+		size             int
+		contractAddress  *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getAccountTokenTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}
+
+	GetAccountNftTransfersStub func(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error)
+	getAccountNftTransfersArgs []struct {
+		accountAddress   string
+		page             int
+		size             int
+		contractAddress  *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getAccountNftTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}
+
+	GetAccountKIP17NftBalancesStub func(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], error)
+	getAccountKIP17NftBalancesArgs []struct {
+		accountAddress string
+		page           int
+		size           int
+	}
+	getAccountKIP17NftBalancesReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]]
+		result2 error
+	}
+
+	GetAccountKIP37NftBalancesStub func(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], error)
+	getAccountKIP37NftBalancesArgs []struct {
+		accountAddress string
+		page           int
+		size           int
+	}
+	getAccountKIP37NftBalancesReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]]
+		result2 error
+	}
+
+	GetAccountEventLogsStub func(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], error)
+	getAccountEventLogsArgs []struct {
+		accountAddress   string
+		page             int
+		size             int
+		signature        *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getAccountEventLogsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]]
+		result2 error
+	}
+}
+
+var _ kaiascan.AccountsAPI = (*FakeAccountsAPI)(nil)
+
+func (f *FakeAccountsAPI) GetAccountInfo(accountAddress string) (*kaiascan.ApiResponse[kaiascan.AccountInfo], error) {
+	f.mu.Lock()
+	f.getAccountInfoArgs = append(f.getAccountInfoArgs, struct{ accountAddress string }{accountAddress})
+	stub, returns := f.GetAccountInfoStub, f.getAccountInfoReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountInfoCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountInfoArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountInfoArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountInfoArgs[i]
+	return args.accountAddress
+}
+
+func (f *FakeAccountsAPI) GetAccountInfoReturns(result1 *kaiascan.ApiResponse[kaiascan.AccountInfo], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountInfoStub = nil
+	f.getAccountInfoReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.AccountInfo]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountKeyHistories(accountAddress string, page int, size int) (*kaiascan.ApiResponse[any], error) {
+	f.mu.Lock()
+	f.getAccountKeyHistoriesArgs = append(f.getAccountKeyHistoriesArgs, struct {
+		accountAddress string
+		page           int
+		size           int
+	}{accountAddress, page, size})
+	stub, returns := f.GetAccountKeyHistoriesStub, f.getAccountKeyHistoriesReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountKeyHistoriesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountKeyHistoriesArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountKeyHistoriesArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountKeyHistoriesArgs[i]
+	return args.accountAddress, args.page, args.size
+}
+
+func (f *FakeAccountsAPI) GetAccountKeyHistoriesReturns(result1 *kaiascan.ApiResponse[any], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountKeyHistoriesStub = nil
+	f.getAccountKeyHistoriesReturns = struct {
+		result1 *kaiascan.ApiResponse[any]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string, directions []string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error) {
+	f.mu.Lock()
+	f.getAccountTransactionsArgs = append(f.getAccountTransactionsArgs, struct {
+		accountAddress   string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		txType           *string
+		directions       []string
+	}{accountAddress, page, size, blockNumberStart, blockNumberEnd, txType, directions})
+	stub, returns := f.GetAccountTransactionsStub, f.getAccountTransactionsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size, blockNumberStart, blockNumberEnd, txType, directions)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountTransactionsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountTransactionsArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountTransactionsArgsForCall(i int) (string, int, int, *int, *int, *string, []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountTransactionsArgs[i]
+	return args.accountAddress, args.page, args.size, args.blockNumberStart, args.blockNumberEnd, args.txType, args.directions
+}
+
+func (f *FakeAccountsAPI) GetAccountTransactionsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountTransactionsStub = nil
+	f.getAccountTransactionsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetFeePaidTransactions(accountAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int, txType *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error) {
+	f.mu.Lock()
+	f.getFeePaidTransactionsArgs = append(f.getFeePaidTransactionsArgs, struct {
+		accountAddress   string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+		txType           *string
+	}{accountAddress, page, size, blockNumberStart, blockNumberEnd, txType})
+	stub, returns := f.GetFeePaidTransactionsStub, f.getFeePaidTransactionsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size, blockNumberStart, blockNumberEnd, txType)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetFeePaidTransactionsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getFeePaidTransactionsArgs)
+}
+
+func (f *FakeAccountsAPI) GetFeePaidTransactionsArgsForCall(i int) (string, int, int, *int, *int, *string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getFeePaidTransactionsArgs[i]
+	return args.accountAddress, args.page, args.size, args.blockNumberStart, args.blockNumberEnd, args.txType
+}
+
+func (f *FakeAccountsAPI) GetFeePaidTransactionsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetFeePaidTransactionsStub = nil
+	f.getFeePaidTransactionsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenBalances(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], error) {
+	f.mu.Lock()
+	f.getAccountTokenBalancesArgs = append(f.getAccountTokenBalancesArgs, struct {
+		accountAddress string
+		page           int
+		size           int
+	}{accountAddress, page, size})
+	stub, returns := f.GetAccountTokenBalancesStub, f.getAccountTokenBalancesReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenBalancesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountTokenBalancesArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenBalancesArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountTokenBalancesArgs[i]
+	return args.accountAddress, args.page, args.size
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenBalancesReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountTokenBalancesStub = nil
+	f.getAccountTokenBalancesReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenDetails(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], error) {
+	f.mu.Lock()
+	f.getAccountTokenDetailsArgs = append(f.getAccountTokenDetailsArgs, struct {
+		accountAddress string
+		page           int
+		size           int
+	}{accountAddress, page, size})
+	stub, returns := f.GetAccountTokenDetailsStub, f.getAccountTokenDetailsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenDetailsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountTokenDetailsArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenDetailsArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountTokenDetailsArgs[i]
+	return args.accountAddress, args.page, args.size
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenDetailsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountTokenDetailsStub = nil
+	f.getAccountTokenDetailsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBalance]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error) {
+	f.mu.Lock()
+	f.getAccountTokenTransfersArgs = append(f.getAccountTokenTransfersArgs, struct {
+		accountAddress   string
+		page             int
+		size             int
+		contractAddress  *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetAccountTokenTransfersStub, f.getAccountTokenTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountTokenTransfersArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenTransfersArgsForCall(i int) (string, int, int, *string, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountTokenTransfersArgs[i]
+	return args.accountAddress, args.page, args.size, args.contractAddress, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeAccountsAPI) GetAccountTokenTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountTokenTransfersStub = nil
+	f.getAccountTokenTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountNftTransfers(accountAddress string, page int, size int, contractAddress *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error) {
+	f.mu.Lock()
+	f.getAccountNftTransfersArgs = append(f.getAccountNftTransfersArgs, struct {
+		accountAddress   string
+		page             int
+		size             int
+		contractAddress  *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetAccountNftTransfersStub, f.getAccountNftTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size, contractAddress, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountNftTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountNftTransfersArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountNftTransfersArgsForCall(i int) (string, int, int, *string, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountNftTransfersArgs[i]
+	return args.accountAddress, args.page, args.size, args.contractAddress, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeAccountsAPI) GetAccountNftTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountNftTransfersStub = nil
+	f.getAccountNftTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP17NftBalances(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], error) {
+	f.mu.Lock()
+	f.getAccountKIP17NftBalancesArgs = append(f.getAccountKIP17NftBalancesArgs, struct {
+		accountAddress string
+		page           int
+		size           int
+	}{accountAddress, page, size})
+	stub, returns := f.GetAccountKIP17NftBalancesStub, f.getAccountKIP17NftBalancesReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP17NftBalancesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountKIP17NftBalancesArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP17NftBalancesArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountKIP17NftBalancesArgs[i]
+	return args.accountAddress, args.page, args.size
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP17NftBalancesReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountKIP17NftBalancesStub = nil
+	f.getAccountKIP17NftBalancesReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP37NftBalances(accountAddress string, page int, size int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], error) {
+	f.mu.Lock()
+	f.getAccountKIP37NftBalancesArgs = append(f.getAccountKIP37NftBalancesArgs, struct {
+		accountAddress string
+		page           int
+		size           int
+	}{accountAddress, page, size})
+	stub, returns := f.GetAccountKIP37NftBalancesStub, f.getAccountKIP37NftBalancesReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP37NftBalancesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountKIP37NftBalancesArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP37NftBalancesArgsForCall(i int) (string, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountKIP37NftBalancesArgs[i]
+	return args.accountAddress, args.page, args.size
+}
+
+func (f *FakeAccountsAPI) GetAccountKIP37NftBalancesReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountKIP37NftBalancesStub = nil
+	f.getAccountKIP37NftBalancesReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftBalance]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeAccountsAPI) GetAccountEventLogs(accountAddress string, page int, size int, signature *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], error) {
+	f.mu.Lock()
+	f.getAccountEventLogsArgs = append(f.getAccountEventLogsArgs, struct {
+		accountAddress   string
+		page             int
+		size             int
+		signature        *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{accountAddress, page, size, signature, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetAccountEventLogsStub, f.getAccountEventLogsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(accountAddress, page, size, signature, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeAccountsAPI) GetAccountEventLogsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getAccountEventLogsArgs)
+}
+
+func (f *FakeAccountsAPI) GetAccountEventLogsArgsForCall(i int) (string, int, int, *string, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getAccountEventLogsArgs[i]
+	return args.accountAddress, args.page, args.size, args.signature, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeAccountsAPI) GetAccountEventLogsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetAccountEventLogsStub = nil
+	f.getAccountEventLogsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]]
+		result2 error
+	}{result1, result2}
+}
+
+type FakeTokensAPI struct {
+	mu sync.Mutex
+
+	GetFungibleTokenStub    func(tokenAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.TokenInfo], error)
+	getFungibleTokenArgs    []struct{ tokenAddress kaiascan.Address }
+	getFungibleTokenReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.TokenInfo]
+		result2 error
+	}
+
+	GetTokenHoldersStub func(tokenAddress string, page int, size int, holderAddress *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenHolder]], error)
+	getTokenHoldersArgs []struct {
+		tokenAddress  string
+		page          int
+		size          int
+		holderAddress *string
+	}
+	getTokenHoldersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenHolder]]
+		result2 error
+	}
+
+	GetTokenTransfersStub func(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error)
+	getTokenTransfersArgs []struct {
+		tokenAddress     string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getTokenTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}
+
+	GetTokenBurnsStub func(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]], error)
+	getTokenBurnsArgs []struct {
+		tokenAddress     string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getTokenBurnsReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]]
+		result2 error
+	}
+}
+
+var _ kaiascan.TokensAPI = (*FakeTokensAPI)(nil)
+
+func (f *FakeTokensAPI) GetFungibleToken(tokenAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.TokenInfo], error) {
+	f.mu.Lock()
+	f.getFungibleTokenArgs = append(f.getFungibleTokenArgs, struct{ tokenAddress kaiascan.Address }{tokenAddress})
+	stub, returns := f.GetFungibleTokenStub, f.getFungibleTokenReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTokensAPI) GetFungibleTokenCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getFungibleTokenArgs)
+}
+
+func (f *FakeTokensAPI) GetFungibleTokenArgsForCall(i int) kaiascan.Address {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getFungibleTokenArgs[i]
+	return args.tokenAddress
+}
+
+func (f *FakeTokensAPI) GetFungibleTokenReturns(result1 *kaiascan.ApiResponse[kaiascan.TokenInfo], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetFungibleTokenStub = nil
+	f.getFungibleTokenReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.TokenInfo]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTokensAPI) GetTokenHolders(tokenAddress string, page int, size int, holderAddress *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenHolder]], error) {
+	f.mu.Lock()
+	f.getTokenHoldersArgs = append(f.getTokenHoldersArgs, struct {
+		tokenAddress  string
+		page          int
+		size          int
+		holderAddress *string
+	}{tokenAddress, page, size, holderAddress})
+	stub, returns := f.GetTokenHoldersStub, f.getTokenHoldersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, holderAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTokensAPI) GetTokenHoldersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTokenHoldersArgs)
+}
+
+func (f *FakeTokensAPI) GetTokenHoldersArgsForCall(i int) (string, int, int, *string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTokenHoldersArgs[i]
+	return args.tokenAddress, args.page, args.size, args.holderAddress
+}
+
+func (f *FakeTokensAPI) GetTokenHoldersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenHolder]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTokenHoldersStub = nil
+	f.getTokenHoldersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenHolder]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTokensAPI) GetTokenTransfers(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], error) {
+	f.mu.Lock()
+	f.getTokenTransfersArgs = append(f.getTokenTransfersArgs, struct {
+		tokenAddress     string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{tokenAddress, page, size, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetTokenTransfersStub, f.getTokenTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTokensAPI) GetTokenTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTokenTransfersArgs)
+}
+
+func (f *FakeTokensAPI) GetTokenTransfersArgsForCall(i int) (string, int, int, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTokenTransfersArgs[i]
+	return args.tokenAddress, args.page, args.size, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeTokensAPI) GetTokenTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTokenTransfersStub = nil
+	f.getTokenTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeTokensAPI) GetTokenBurns(tokenAddress string, page int, size int, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]], error) {
+	f.mu.Lock()
+	f.getTokenBurnsArgs = append(f.getTokenBurnsArgs, struct {
+		tokenAddress     string
+		page             int
+		size             int
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{tokenAddress, page, size, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetTokenBurnsStub, f.getTokenBurnsReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeTokensAPI) GetTokenBurnsCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getTokenBurnsArgs)
+}
+
+func (f *FakeTokensAPI) GetTokenBurnsArgsForCall(i int) (string, int, int, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getTokenBurnsArgs[i]
+	return args.tokenAddress, args.page, args.size, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeTokensAPI) GetTokenBurnsReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetTokenBurnsStub = nil
+	f.getTokenBurnsReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]]
+		result2 error
+	}{result1, result2}
+}
+
+type FakeNftsAPI struct {
+	mu sync.Mutex
+
+	GetNftInfoStub    func(tokenAddress string) (*kaiascan.ApiResponse[kaiascan.NftCollection], error)
+	getNftInfoArgs    []struct{ tokenAddress string }
+	getNftInfoReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.NftCollection]
+		result2 error
+	}
+
+	GetNftItemStub func(nftAddress kaiascan.Address, tokenId string) (*kaiascan.ApiResponse[kaiascan.NftItem], error)
+	getNftItemArgs []struct {
+		nftAddress kaiascan.Address
+		tokenId    string
+	}
+	getNftItemReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.NftItem]
+		result2 error
+	}
+
+	GetNftHoldersStub func(tokenAddress string, page int, size int, tokenId *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftHolder]], error)
+	getNftHoldersArgs []struct {
+		tokenAddress string
+		page         int
+		size         int
+		tokenId      *string
+	}
+	getNftHoldersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftHolder]]
+		result2 error
+	}
+
+	GetNftTransfersStub func(tokenAddress string, page int, size int, tokenId *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error)
+	getNftTransfersArgs []struct {
+		tokenAddress     string
+		page             int
+		size             int
+		tokenId          *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}
+	getNftTransfersReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}
+
+	GetNftInventoriesStub func(tokenAddress string, page int, size int, keyword *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftInventoryEntry]], error)
+	getNftInventoriesArgs []struct {
+		tokenAddress string
+		page         int
+		size         int
+		keyword      *string
+	}
+	getNftInventoriesReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftInventoryEntry]]
+		result2 error
+	}
+}
+
+var _ kaiascan.NftsAPI = (*FakeNftsAPI)(nil)
+
+func (f *FakeNftsAPI) GetNftInfo(tokenAddress string) (*kaiascan.ApiResponse[kaiascan.NftCollection], error) {
+	f.mu.Lock()
+	f.getNftInfoArgs = append(f.getNftInfoArgs, struct{ tokenAddress string }{tokenAddress})
+	stub, returns := f.GetNftInfoStub, f.getNftInfoReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeNftsAPI) GetNftInfoCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getNftInfoArgs)
+}
+
+func (f *FakeNftsAPI) GetNftInfoArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getNftInfoArgs[i]
+	return args.tokenAddress
+}
+
+func (f *FakeNftsAPI) GetNftInfoReturns(result1 *kaiascan.ApiResponse[kaiascan.NftCollection], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetNftInfoStub = nil
+	f.getNftInfoReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.NftCollection]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeNftsAPI) GetNftItem(nftAddress kaiascan.Address, tokenId string) (*kaiascan.ApiResponse[kaiascan.NftItem], error) {
+	f.mu.Lock()
+	f.getNftItemArgs = append(f.getNftItemArgs, struct {
+		nftAddress kaiascan.Address
+		tokenId    string
+	}{nftAddress, tokenId})
+	stub, returns := f.GetNftItemStub, f.getNftItemReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(nftAddress, tokenId)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeNftsAPI) GetNftItemCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getNftItemArgs)
+}
+
+func (f *FakeNftsAPI) GetNftItemArgsForCall(i int) (kaiascan.Address, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getNftItemArgs[i]
+	return args.nftAddress, args.tokenId
+}
+
+func (f *FakeNftsAPI) GetNftItemReturns(result1 *kaiascan.ApiResponse[kaiascan.NftItem], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetNftItemStub = nil
+	f.getNftItemReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.NftItem]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeNftsAPI) GetNftHolders(tokenAddress string, page int, size int, tokenId *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftHolder]], error) {
+	f.mu.Lock()
+	f.getNftHoldersArgs = append(f.getNftHoldersArgs, struct {
+		tokenAddress string
+		page         int
+		size         int
+		tokenId      *string
+	}{tokenAddress, page, size, tokenId})
+	stub, returns := f.GetNftHoldersStub, f.getNftHoldersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, tokenId)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeNftsAPI) GetNftHoldersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getNftHoldersArgs)
+}
+
+func (f *FakeNftsAPI) GetNftHoldersArgsForCall(i int) (string, int, int, *string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getNftHoldersArgs[i]
+	return args.tokenAddress, args.page, args.size, args.tokenId
+}
+
+func (f *FakeNftsAPI) GetNftHoldersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftHolder]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetNftHoldersStub = nil
+	f.getNftHoldersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftHolder]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeNftsAPI) GetNftTransfers(tokenAddress string, page int, size int, tokenId *string, blockNumberStart *int, blockNumberEnd *int) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], error) {
+	f.mu.Lock()
+	f.getNftTransfersArgs = append(f.getNftTransfersArgs, struct {
+		tokenAddress     string
+		page             int
+		size             int
+		tokenId          *string
+		blockNumberStart *int
+		blockNumberEnd   *int
+	}{tokenAddress, page, size, tokenId, blockNumberStart, blockNumberEnd})
+	stub, returns := f.GetNftTransfersStub, f.getNftTransfersReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, tokenId, blockNumberStart, blockNumberEnd)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeNftsAPI) GetNftTransfersCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getNftTransfersArgs)
+}
+
+func (f *FakeNftsAPI) GetNftTransfersArgsForCall(i int) (string, int, int, *string, *int, *int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getNftTransfersArgs[i]
+	return args.tokenAddress, args.page, args.size, args.tokenId, args.blockNumberStart, args.blockNumberEnd
+}
+
+func (f *FakeNftsAPI) GetNftTransfersReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetNftTransfersStub = nil
+	f.getNftTransfersReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftTransfer]]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeNftsAPI) GetNftInventories(tokenAddress string, page int, size int, keyword *string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftInventoryEntry]], error) {
+	f.mu.Lock()
+	f.getNftInventoriesArgs = append(f.getNftInventoriesArgs, struct {
+		tokenAddress string
+		page         int
+		size         int
+		keyword      *string
+	}{tokenAddress, page, size, keyword})
+	stub, returns := f.GetNftInventoriesStub, f.getNftInventoriesReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(tokenAddress, page, size, keyword)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeNftsAPI) GetNftInventoriesCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getNftInventoriesArgs)
+}
+
+func (f *FakeNftsAPI) GetNftInventoriesArgsForCall(i int) (string, int, int, *string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getNftInventoriesArgs[i]
+	return args.tokenAddress, args.page, args.size, args.keyword
+}
+
+func (f *FakeNftsAPI) GetNftInventoriesReturns(result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftInventoryEntry]], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetNftInventoriesStub = nil
+	f.getNftInventoriesReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.Page[kaiascan.NftInventoryEntry]]
+		result2 error
+	}{result1, result2}
+}
+
+type FakeContractsAPI struct {
+	mu sync.Mutex
+
+	GetContractInfoStub    func(contractAddress string) (*kaiascan.ApiResponse[kaiascan.ContractInfo], error)
+	getContractInfoArgs    []struct{ contractAddress string }
+	getContractInfoReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractInfo]
+		result2 error
+	}
+
+	GetContractsInfoStub    func(contractAddresses []string) (*kaiascan.ApiResponse[[]kaiascan.ContractInfo], error)
+	getContractsInfoArgs    []struct{ contractAddresses []string }
+	getContractsInfoReturns struct {
+		result1 *kaiascan.ApiResponse[[]kaiascan.ContractInfo]
+		result2 error
+	}
+
+	GetContractAbiStub    func(contractAddress string) (*kaiascan.ApiResponse[kaiascan.ContractAbi], error)
+	getContractAbiArgs    []struct{ contractAddress string }
+	getContractAbiReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractAbi]
+		result2 error
+	}
+
+	GetContractSourceCodeStub    func(contractAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.ContractSourceCode], error)
+	getContractSourceCodeArgs    []struct{ contractAddress kaiascan.Address }
+	getContractSourceCodeReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractSourceCode]
+		result2 error
+	}
+
+	GetContractCreationCodeStub    func(contractAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.ContractCreationCode], error)
+	getContractCreationCodeArgs    []struct{ contractAddress kaiascan.Address }
+	getContractCreationCodeReturns struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractCreationCode]
+		result2 error
+	}
+}
+
+var _ kaiascan.ContractsAPI = (*FakeContractsAPI)(nil)
+
+func (f *FakeContractsAPI) GetContractInfo(contractAddress string) (*kaiascan.ApiResponse[kaiascan.ContractInfo], error) {
+	f.mu.Lock()
+	f.getContractInfoArgs = append(f.getContractInfoArgs, struct{ contractAddress string }{contractAddress})
+	stub, returns := f.GetContractInfoStub, f.getContractInfoReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(contractAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeContractsAPI) GetContractInfoCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getContractInfoArgs)
+}
+
+func (f *FakeContractsAPI) GetContractInfoArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getContractInfoArgs[i]
+	return args.contractAddress
+}
+
+func (f *FakeContractsAPI) GetContractInfoReturns(result1 *kaiascan.ApiResponse[kaiascan.ContractInfo], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetContractInfoStub = nil
+	f.getContractInfoReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractInfo]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeContractsAPI) GetContractsInfo(contractAddresses []string) (*kaiascan.ApiResponse[[]kaiascan.ContractInfo], error) {
+	f.mu.Lock()
+	f.getContractsInfoArgs = append(f.getContractsInfoArgs, struct{ contractAddresses []string }{contractAddresses})
+	stub, returns := f.GetContractsInfoStub, f.getContractsInfoReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(contractAddresses)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeContractsAPI) GetContractsInfoCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getContractsInfoArgs)
+}
+
+func (f *FakeContractsAPI) GetContractsInfoArgsForCall(i int) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getContractsInfoArgs[i]
+	return args.contractAddresses
+}
+
+func (f *FakeContractsAPI) GetContractsInfoReturns(result1 *kaiascan.ApiResponse[[]kaiascan.ContractInfo], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetContractsInfoStub = nil
+	f.getContractsInfoReturns = struct {
+		result1 *kaiascan.ApiResponse[[]kaiascan.ContractInfo]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeContractsAPI) GetContractAbi(contractAddress string) (*kaiascan.ApiResponse[kaiascan.ContractAbi], error) {
+	f.mu.Lock()
+	f.getContractAbiArgs = append(f.getContractAbiArgs, struct{ contractAddress string }{contractAddress})
+	stub, returns := f.GetContractAbiStub, f.getContractAbiReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(contractAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeContractsAPI) GetContractAbiCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getContractAbiArgs)
+}
+
+func (f *FakeContractsAPI) GetContractAbiArgsForCall(i int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getContractAbiArgs[i]
+	return args.contractAddress
+}
+
+func (f *FakeContractsAPI) GetContractAbiReturns(result1 *kaiascan.ApiResponse[kaiascan.ContractAbi], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetContractAbiStub = nil
+	f.getContractAbiReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractAbi]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeContractsAPI) GetContractSourceCode(contractAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.ContractSourceCode], error) {
+	f.mu.Lock()
+	f.getContractSourceCodeArgs = append(f.getContractSourceCodeArgs, struct{ contractAddress kaiascan.Address }{contractAddress})
+	stub, returns := f.GetContractSourceCodeStub, f.getContractSourceCodeReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(contractAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeContractsAPI) GetContractSourceCodeCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getContractSourceCodeArgs)
+}
+
+func (f *FakeContractsAPI) GetContractSourceCodeArgsForCall(i int) kaiascan.Address {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getContractSourceCodeArgs[i]
+	return args.contractAddress
+}
+
+func (f *FakeContractsAPI) GetContractSourceCodeReturns(result1 *kaiascan.ApiResponse[kaiascan.ContractSourceCode], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetContractSourceCodeStub = nil
+	f.getContractSourceCodeReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractSourceCode]
+		result2 error
+	}{result1, result2}
+}
+
+func (f *FakeContractsAPI) GetContractCreationCode(contractAddress kaiascan.Address) (*kaiascan.ApiResponse[kaiascan.ContractCreationCode], error) {
+	f.mu.Lock()
+	f.getContractCreationCodeArgs = append(f.getContractCreationCodeArgs, struct{ contractAddress kaiascan.Address }{contractAddress})
+	stub, returns := f.GetContractCreationCodeStub, f.getContractCreationCodeReturns
+	f.mu.Unlock()
+	if stub != nil {
+		return stub(contractAddress)
+	}
+	return returns.result1, returns.result2
+}
+
+func (f *FakeContractsAPI) GetContractCreationCodeCallCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.getContractCreationCodeArgs)
+}
+
+func (f *FakeContractsAPI) GetContractCreationCodeArgsForCall(i int) kaiascan.Address {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := f.getContractCreationCodeArgs[i]
+	return args.contractAddress
+}
+
+func (f *FakeContractsAPI) GetContractCreationCodeReturns(result1 *kaiascan.ApiResponse[kaiascan.ContractCreationCode], result2 error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetContractCreationCodeStub = nil
+	f.getContractCreationCodeReturns = struct {
+		result1 *kaiascan.ApiResponse[kaiascan.ContractCreationCode]
+		result2 error
+	}{result1, result2}
+}
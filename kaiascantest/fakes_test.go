@@ -0,0 +1,100 @@
+package kaiascantest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	kaiascan "kaiascan.go"
+	"kaiascan.go/units"
+)
+
+func latestBlockNumber(blocks kaiascan.BlocksAPI) (int64, error) {
+	resp, err := blocks.GetLatestBlock()
+	if err != nil {
+		return 0, err
+	}
+	return resp.Data.BlockNumber, nil
+}
+
+func TestFakeAPI(t *testing.T) {
+	fake := &FakeAPI{}
+	fake.GetLatestBlockReturns(&kaiascan.ApiResponse[kaiascan.Block]{Data: kaiascan.Block{BlockNumber: 7}}, nil)
+
+	n, err := latestBlockNumber(fake)
+	if err != nil || n != 7 {
+		t.Errorf("Unexpected result: %d, %v", n, err)
+	}
+	if fake.GetLatestBlockCallCount() != 1 {
+		t.Errorf("Expected one call, got %d", fake.GetLatestBlockCallCount())
+	}
+
+	fake.GetAccountTransactionsStub = func(address string, page int, size int, start *int, end *int, txType *string, directions []string) (*kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]], error) {
+		if page > 1 {
+			return nil, errors.New("boom")
+		}
+		return &kaiascan.ApiResponse[kaiascan.Page[kaiascan.Transaction]]{}, nil
+	}
+	start := 100
+	var api kaiascan.API = fake
+	if _, err := api.GetAccountTransactions("0xabc", 1, 50, &start, nil, nil, []string{"from"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if _, err := api.GetAccountTransactions("0xabc", 2, 50, nil, nil, nil, nil); err == nil {
+		t.Error("Expected the stub error")
+	}
+
+	address, page, size, blockStart, _, _, directions := fake.GetAccountTransactionsArgsForCall(0)
+	if address != "0xabc" || page != 1 || size != 50 || *blockStart != 100 || directions[0] != "from" {
+		t.Errorf("Unexpected recorded arguments: %s %d %d %v %v", address, page, size, blockStart, directions)
+	}
+	if fake.GetAccountTransactionsCallCount() != 2 || fake.GetNftInfoCallCount() != 0 {
+		t.Error("Unexpected call counts")
+	}
+}
+
+func TestFakeAPI_Helpers(t *testing.T) {
+	fake := &FakeAPI{}
+	fake.GetLatestBlockReturns(&kaiascan.ApiResponse[kaiascan.Block]{Data: kaiascan.Block{BlockNumber: 100}}, nil)
+	fake.GetTokenTransfersReturns(&kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenTransfer]]{Data: kaiascan.Page[kaiascan.TokenTransfer]{
+		Paging: kaiascan.Paging{Last: true},
+		Results: []kaiascan.TokenTransfer{
+			{TransactionHash: "0x1", BlockNumber: 10, From: Alice, To: Bob, Amount: units.NewAmount(big.NewInt(5))},
+		},
+	}}, nil)
+	fake.GetTokenBurnsReturns(&kaiascan.ApiResponse[kaiascan.Page[kaiascan.TokenBurn]]{Data: kaiascan.Page[kaiascan.TokenBurn]{Paging: kaiascan.Paging{Last: true}}}, nil)
+
+	snapshot, err := kaiascan.NewHelpers(fake).SnapshotTokenHolders(context.Background(), "0xtoken", 50)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(snapshot.Holders) != 1 || snapshot.Holders[0].Address != strings.ToLower(Bob) || snapshot.Checked {
+		t.Errorf("Unexpected snapshot: %+v", snapshot)
+	}
+	if fake.GetTokenTransfersCallCount() != 1 || fake.GetTokenBurnsCallCount() != 1 || fake.GetLatestBlockCallCount() != 1 {
+		t.Error("Expected the snapshot to be read from the fake")
+	}
+	if tokenAddress, _, _, _, end := fake.GetTokenTransfersArgsForCall(0); tokenAddress != "0xtoken" || *end != 50 {
+		t.Errorf("Unexpected recorded arguments: %s %v", tokenAddress, end)
+	}
+}
+
+func TestFakeAPI_ResolveImplementation(t *testing.T) {
+	fake := &FakeAPI{}
+	fake.GetContractInfoReturns(&kaiascan.ApiResponse[kaiascan.ContractInfo]{Data: kaiascan.ContractInfo{Proxy: true, ImplementationAddress: Bob}}, nil)
+	fake.GetContractCreationCodeReturns(nil, &kaiascan.APIError{Code: 404, Msg: "not found"})
+	fake.GetAccountEventLogsReturns(&kaiascan.ApiResponse[kaiascan.Page[kaiascan.EventLog]]{Data: kaiascan.Page[kaiascan.EventLog]{Paging: kaiascan.Paging{Last: true}}}, nil)
+
+	resolution, err := kaiascan.NewHelpers(fake).ResolveImplementation(context.Background(), Alice)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resolution.Kind != kaiascan.ProxyKindUnknown || resolution.Implementation != Bob {
+		t.Errorf("Unexpected resolution: %+v", resolution)
+	}
+	if fake.GetAccountEventLogsCallCount() != 3 {
+		t.Errorf("Expected one event log query per upgrade topic, got %d", fake.GetAccountEventLogsCallCount())
+	}
+}
@@ -0,0 +1,169 @@
+package kaiascantest
+
+import (
+	"math/big"
+	"time"
+
+	kaiascan "kaiascan.go"
+	"kaiascan.go/units"
+)
+
+// Fixtures is the data a Server serves. Lists are returned in the order
+// given here. Maps are keyed by address and matched case-insensitively.
+type Fixtures struct {
+	Blocks         []kaiascan.Block
+	Transactions   []kaiascan.Transaction
+	Accounts       []kaiascan.AccountInfo
+	TokenBalances  map[string][]kaiascan.TokenBalance
+	NftBalances    map[string][]kaiascan.NftBalance
+	Tokens         map[string]kaiascan.TokenInfo
+	TokenTransfers []kaiascan.TokenTransfer
+	TokenHolders   map[string][]kaiascan.TokenHolder
+	TokenBurns     map[string][]kaiascan.TokenBurn
+	NftCollections []kaiascan.NftCollection
+	NftItems       []kaiascan.NftItem
+	NftTransfers   []kaiascan.NftTransfer
+	NftHolders     map[string][]kaiascan.NftHolder
+	NftInventories map[string][]kaiascan.NftInventoryEntry
+	Contracts      []kaiascan.ContractInfo
+	SourceCodes    []kaiascan.ContractSourceCode
+	CreationCodes  []kaiascan.ContractCreationCode
+	Abis           []kaiascan.ContractAbi
+	EventLogs      []kaiascan.EventLog
+	// The SDK leaves the following payloads untyped. Burns and rewards are
+	// keyed by block number and default to an empty object for known
+	// blocks; internal transactions and input data are keyed by
+	// transaction hash.
+	BlockBurns           map[int64]any
+	BlockRewards         map[int64]any
+	InternalTransactions map[string][]any
+	InputData            map[string]any
+	// Raw overrides the response data of any endpoint by its path without
+	// the /api/v1/ prefix, such as "blocks/12/rewards".
+	Raw map[string]any
+}
+
+const (
+	Alice   = "0x00000000000000000000000000000000000a11ce"
+	Bob     = "0x0000000000000000000000000000000000000b0b"
+	Relayer = "0x00000000000000000000000000000000000fee00"
+	Token   = "0x0000000000000000000000000000000000007070"
+	Nft     = "0x00000000000000000000000000000000000000f7"
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// DefaultFixtures returns a small, consistent chain: ten blocks one second
+// apart, Alice and Bob exchanging KAIA and a 6-decimal token, a fee-delegated
+// transaction paid by Relayer, and an NFT minted to Alice.
+func DefaultFixtures() Fixtures {
+	genesis := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(n int64) time.Time { return genesis.Add(time.Duration(n) * time.Second) }
+	kaia := func(n int64) units.Amount {
+		return units.NewAmount(new(big.Int).Mul(big.NewInt(n), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+	}
+	raw := func(n int64) units.Amount { return units.NewAmount(big.NewInt(n)) }
+
+	f := Fixtures{
+		Tokens: map[string]kaiascan.TokenInfo{
+			Token: {ContractType: "KIP7", Name: "Test USD", Symbol: "TUSD", Decimal: 6, TotalSupply: 1000},
+		},
+		TokenBalances: map[string][]kaiascan.TokenBalance{
+			Alice: {{ContractAddress: Token, Balance: raw(750_000_000)}},
+			Bob:   {{ContractAddress: Token, Balance: raw(250_000_000)}},
+		},
+		NftBalances: map[string][]kaiascan.NftBalance{
+			Alice: {{ContractAddress: Nft, Kind: kaiascan.NftKindKIP17, Name: "Test Punks", Symbol: "TPUNK", TokenId: "1", TokenCount: raw(1)}},
+		},
+		TokenHolders: map[string][]kaiascan.TokenHolder{
+			Token: {{HolderAddress: Alice, Amount: raw(750_000_000)}, {HolderAddress: Bob, Amount: raw(250_000_000)}},
+		},
+		TokenBurns: map[string][]kaiascan.TokenBurn{},
+		NftCollections: []kaiascan.NftCollection{
+			{ContractAddress: Nft, Kind: kaiascan.NftKindKIP17, Name: "Test Punks", Symbol: "TPUNK", TotalSupply: raw(1), TotalTransfers: 1, HolderCount: 1},
+		},
+		NftItems: []kaiascan.NftItem{
+			{ContractAddress: Nft, Kind: kaiascan.NftKindKIP17, TokenId: "1", Owner: Alice, TokenUri: "ipfs://bafytest/1.json", TotalSupply: raw(1), TotalTransfers: 1},
+		},
+		NftHolders: map[string][]kaiascan.NftHolder{
+			Nft: {{HolderAddress: Alice, TokenId: "1", TokenCount: raw(1)}},
+		},
+		NftInventories: map[string][]kaiascan.NftInventoryEntry{
+			Nft: {{ContractAddress: Nft, Kind: kaiascan.NftKindKIP17, TokenId: "1", HolderAddress: Alice, TokenUri: "ipfs://bafytest/1.json", TokenCount: raw(1)}},
+		},
+		Contracts: []kaiascan.ContractInfo{
+			{ContractAddress: Token, Name: "TestUSD", Verified: true, CompilerVersion: "v0.8.24+commit.e11b9ed9", Deployer: Alice, CreationTransactionHash: TxHash(1)},
+		},
+		BlockBurns:   map[int64]any{},
+		BlockRewards: map[int64]any{},
+		InternalTransactions: map[string][]any{
+			TxHash(3): {map[string]any{"from": Token, "to": Bob, "value": "0", "callType": "call"}},
+		},
+		InputData: map[string]any{
+			TxHash(3): map[string]any{
+				"originalValue": "0xa9059cbb" + leftPad(Bob[2:], 64) + leftPad(big.NewInt(250_000_000).Text(16), 64),
+				"decodedValue":  map[string]any{"methodId": "0xa9059cbb", "signature": "transfer(address,uint256)"},
+			},
+		},
+		Raw: map[string]any{},
+	}
+
+	for n := int64(1); n <= 10; n++ {
+		block := kaiascan.Block{
+			BlockNumber:   n,
+			Hash:          blockHash(n),
+			ParentHash:    blockHash(n - 1),
+			Datetime:      at(n),
+			BlockProposer: Relayer,
+		}
+		if n <= 5 {
+			block.TotalTransactionCount = 1
+		}
+		f.Blocks = append(f.Blocks, block)
+		f.BlockBurns[n] = map[string]any{"blockId": n, "burntFees": "0"}
+		f.BlockRewards[n] = map[string]any{"blockId": n, "minted": "6400000000000000000", "proposer": Relayer}
+	}
+
+	f.Transactions = []kaiascan.Transaction{
+		{TransactionHash: TxHash(1), BlockNumber: 1, Datetime: at(1), From: Alice, To: "", TransactionType: "TxTypeSmartContractDeploy", Status: "Success", TransactionFee: kaia(1), GasUsed: 1_000_000},
+		{TransactionHash: TxHash(2), BlockNumber: 2, Datetime: at(2), From: Alice, To: Bob, TransactionType: "TxTypeValueTransfer", Status: "Success", Amount: kaia(5), TransactionFee: raw(525_000_000_000_000), GasUsed: 21000},
+		{TransactionHash: TxHash(3), BlockNumber: 3, Datetime: at(3), From: Alice, To: Token, TransactionType: "TxTypeSmartContractExecution", Status: "Success", TransactionFee: raw(1_250_000_000_000_000), GasUsed: 50000},
+		{TransactionHash: TxHash(4), BlockNumber: 4, Datetime: at(4), From: Bob, To: Token, TransactionType: "TxTypeFeeDelegatedSmartContractExecution", Status: "Success", TransactionFee: raw(1_250_000_000_000_000), GasUsed: 50000, FeePayer: Relayer, FeeRatio: 100},
+		{TransactionHash: TxHash(5), BlockNumber: 5, Datetime: at(5), From: Alice, To: Nft, TransactionType: "TxTypeSmartContractExecution", Status: "Success", TransactionFee: raw(2_500_000_000_000_000), GasUsed: 100000},
+	}
+	f.TokenTransfers = []kaiascan.TokenTransfer{
+		{TransactionHash: TxHash(1), BlockNumber: 1, Datetime: at(1), From: zeroAddress, To: Alice, ContractAddress: Token, Amount: raw(1_000_000_000)},
+		{TransactionHash: TxHash(3), BlockNumber: 3, Datetime: at(3), From: Alice, To: Bob, ContractAddress: Token, Amount: raw(250_000_000)},
+	}
+	f.NftTransfers = []kaiascan.NftTransfer{
+		{TransactionHash: TxHash(5), BlockNumber: 5, Datetime: at(5), From: zeroAddress, To: Alice, ContractAddress: Nft, Kind: kaiascan.NftKindKIP17, TokenId: "1", Quantity: raw(1)},
+	}
+	f.EventLogs = []kaiascan.EventLog{
+		{TransactionHash: TxHash(3), BlockNumber: 3, Datetime: at(3), Address: Token, Signature: "Transfer(address,address,uint256)"},
+	}
+
+	f.Accounts = []kaiascan.AccountInfo{
+		{Address: Alice, AccountType: "EOA", Balance: kaia(95), TotalTransactionCount: 4},
+		{Address: Bob, AccountType: "EOA", Balance: kaia(5), TotalTransactionCount: 2},
+		{Address: Relayer, AccountType: "EOA", Balance: kaia(100), TotalTransactionCount: 0},
+		{Address: Token, AccountType: "SCA"},
+		{Address: Nft, AccountType: "SCA"},
+	}
+	return f
+}
+
+func blockHash(n int64) string {
+	return "0x" + leftPad(big.NewInt(n).Text(16), 64)
+}
+
+// TxHash returns the hash of the nth default fixture transaction.
+func TxHash(n int64) string {
+	return "0x" + leftPad("7"+big.NewInt(n).Text(16), 64)
+}
+
+func leftPad(s string, n int) string {
+	for len(s) < n {
+		s = "0" + s
+	}
+	return s
+}
@@ -0,0 +1,557 @@
+// Package kaiascantest provides an in-memory Kaiascan OAPI server for tests
+// of code built on the kaiascan SDK.
+package kaiascantest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	kaiascan "kaiascan.go"
+)
+
+// Fault makes matching requests fail. A non-zero Code is returned as an API
+// error with HTTP 200; otherwise the request fails with Status, or 500.
+type Fault struct {
+	Status int
+	Code   int
+	Msg    string
+	// Times is the number of requests to fail before the fault clears
+	// itself. Zero fails every request.
+	Times int
+}
+
+type fault struct {
+	pattern string
+	Fault
+}
+
+// Server serves Fixtures over the same routes the SDK calls.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures Fixtures
+	faults   []*fault
+	requests []string
+}
+
+func NewServer(fixtures Fixtures) *Server {
+	s := &Server{fixtures: fixtures}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetBaseURL points the SDK at the server and returns a function restoring
+// the previous base URL.
+func (s *Server) SetBaseURL() (restore func()) {
+	previous := kaiascan.BASE_URL
+	kaiascan.BASE_URL = s.URL + "/"
+	return func() { kaiascan.BASE_URL = previous }
+}
+
+// Update changes the fixtures while the server is running, for example to
+// append blocks.
+func (s *Server) Update(fn func(*Fixtures)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.fixtures)
+}
+
+// Fail injects a fault for requests whose path, without the /api/v1/ prefix,
+// matches pattern as in path.Match, such as "accounts/*/transactions".
+func (s *Server) Fail(pattern string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{pattern: pattern, Fault: f})
+}
+
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests lists the requests served so far as normalized path and query.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// normalizePath strips the API prefix and collapses repeated slashes, so that
+// cassettes recorded when the SDK still built doubled slashes keep matching.
+func normalizePath(p string) string {
+	parts := strings.FieldsFunc(p, func(r rune) bool { return r == '/' })
+	if len(parts) >= 2 && parts[0] == "api" && parts[1] == "v1" {
+		parts = parts[2:]
+	}
+	return strings.Join(parts, "/")
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := normalizePath(r.URL.Path)
+	request := p
+	if r.URL.RawQuery != "" {
+		request += "?" + r.URL.RawQuery
+	}
+	s.requests = append(s.requests, request)
+
+	for i, f := range s.faults {
+		if ok, _ := path.Match(f.pattern, p); !ok {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			}
+		}
+		if f.Code != 0 {
+			writeResponse(w, http.StatusOK, f.Code, f.Msg, nil)
+			return
+		}
+		status := f.Status
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		writeResponse(w, status, status, f.Msg, nil)
+		return
+	}
+
+	if data, ok := s.fixtures.Raw[p]; ok {
+		writeData(w, data)
+		return
+	}
+
+	q := r.URL.Query()
+	segments := strings.Split(p, "/")
+	var handled bool
+	switch segments[0] {
+	case "blocks":
+		handled = s.serveBlocks(w, q, segments[1:])
+	case "transactions":
+		handled = s.serveTransactions(w, q, segments[1:])
+	case "transaction-receipts":
+		if len(segments) == 2 && segments[1] == "status" {
+			handled = s.serveTransactionStatus(w, q.Get("transactionHash"))
+		}
+	case "accounts":
+		handled = s.serveAccounts(w, q, segments[1:])
+	case "tokens":
+		handled = s.serveTokens(w, q, segments[1:])
+	case "nfts":
+		handled = s.serveNfts(w, q, segments[1:])
+	case "contracts":
+		handled = s.serveContracts(w, q, segments[1:])
+	}
+	if !handled {
+		notFound(w)
+	}
+}
+
+func writeResponse(w http.ResponseWriter, status int, code int, msg string, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(kaiascan.ApiResponse[any]{Code: code, Msg: msg, Data: data})
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	writeResponse(w, http.StatusOK, 0, "Success", data)
+}
+
+func notFound(w http.ResponseWriter) {
+	writeResponse(w, http.StatusNotFound, http.StatusNotFound, "not found", nil)
+}
+
+// writeFound writes v, or a 404 when ok is false. It always reports the
+// request as handled.
+func writeFound(w http.ResponseWriter, v any, ok bool) bool {
+	if !ok {
+		notFound(w)
+	} else {
+		writeData(w, v)
+	}
+	return true
+}
+
+// paginate writes one page of items following the OAPI paging rules: page
+// starts at 1 and size must be between 1 and 2000, defaulting to 20.
+func paginate[T any](w http.ResponseWriter, q map[string][]string, items []T) bool {
+	page, size := 1, 20
+	var err error
+	if v := first(q, "page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil || page < 1 {
+			writeResponse(w, http.StatusBadRequest, http.StatusBadRequest, "page must be >= 1", nil)
+			return true
+		}
+	}
+	if v := first(q, "size"); v != "" {
+		if size, err = strconv.Atoi(v); err != nil || size < 1 || size > 2000 {
+			writeResponse(w, http.StatusBadRequest, http.StatusBadRequest, "size must be between 1 and 2000", nil)
+			return true
+		}
+	}
+
+	total := len(items)
+	totalPages := (total + size - 1) / size
+	start := min((page-1)*size, total)
+	end := min(start+size, total)
+	results := items[start:end]
+	if results == nil {
+		results = []T{}
+	}
+	writeData(w, kaiascan.Page[T]{
+		Paging: kaiascan.Paging{
+			TotalCount:  int64(total),
+			CurrentPage: page,
+			Last:        page >= totalPages,
+			TotalPage:   totalPages,
+		},
+		Results: results,
+	})
+	return true
+}
+
+func first(q map[string][]string, key string) string {
+	if v := q[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func filter[T any](items []T, keep func(T) bool) []T {
+	result := []T{}
+	for _, item := range items {
+		if keep(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func find[T any](items []T, match func(T) bool) (T, bool) {
+	for _, item := range items {
+		if match(item) {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func lookup[T any](m map[string]T, address string) (T, bool) {
+	for k, v := range m {
+		if strings.EqualFold(k, address) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// inRange applies the blockNumberStart and blockNumberEnd filters.
+func inRange(q map[string][]string, block int64) bool {
+	if v, err := strconv.ParseInt(first(q, "blockNumberStart"), 10, 64); err == nil && block < v {
+		return false
+	}
+	if v, err := strconv.ParseInt(first(q, "blockNumberEnd"), 10, 64); err == nil && block > v {
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveBlocks(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	blocks := s.fixtures.Blocks
+	switch {
+	case len(rest) == 0:
+		number, err := strconv.ParseInt(first(q, "blockNumber"), 10, 64)
+		if err != nil {
+			return false
+		}
+		if _, list := q["page"]; list || q["blockNumberStart"] != nil || q["blockNumberEnd"] != nil {
+			return paginate(w, q, filter(blocks, func(b kaiascan.Block) bool { return inRange(q, b.BlockNumber) }))
+		}
+		block, ok := find(blocks, func(b kaiascan.Block) bool { return b.BlockNumber == number })
+		return writeFound(w, block, ok)
+	case len(rest) == 1 && rest[0] == "latest":
+		if len(blocks) == 0 {
+			return writeFound(w, nil, false)
+		}
+		latest := blocks[0]
+		for _, b := range blocks[1:] {
+			if b.BlockNumber > latest.BlockNumber {
+				latest = b
+			}
+		}
+		return writeFound(w, latest, true)
+	case len(rest) == 2 && rest[0] == "latest" && rest[1] == "burns":
+		numbers := make([]int64, 0, len(s.fixtures.BlockBurns))
+		for n := range s.fixtures.BlockBurns {
+			numbers = append(numbers, n)
+		}
+		slices.Sort(numbers)
+		slices.Reverse(numbers)
+		burns := make([]any, len(numbers))
+		for i, n := range numbers {
+			burns[i] = s.fixtures.BlockBurns[n]
+		}
+		return paginate(w, q, burns)
+	case len(rest) == 2 && rest[0] == "latest" && rest[1] == "rewards":
+		number, err := strconv.ParseInt(first(q, "blockNumber"), 10, 64)
+		if err != nil {
+			return false
+		}
+		return s.serveBlockPayload(w, s.fixtures.BlockRewards, number)
+	case len(rest) == 2 && rest[0] == "timestamps":
+		ts, err := strconv.ParseInt(rest[1], 10, 64)
+		if err != nil {
+			return false
+		}
+		return writeFound(w, filter(blocks, func(b kaiascan.Block) bool { return b.Datetime.Unix() == ts }), true)
+	case len(rest) == 2 && rest[1] == "transactions":
+		number, err := strconv.ParseInt(rest[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		txType := first(q, "type")
+		return paginate(w, q, filter(s.fixtures.Transactions, func(tx kaiascan.Transaction) bool {
+			return tx.BlockNumber == number && (txType == "" || tx.TransactionType == txType)
+		}))
+	case len(rest) == 2:
+		number, err := strconv.ParseInt(rest[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		switch rest[1] {
+		case "burns":
+			return s.serveBlockPayload(w, s.fixtures.BlockBurns, number)
+		case "rewards":
+			return s.serveBlockPayload(w, s.fixtures.BlockRewards, number)
+		case "internal-transactions":
+			internal := []any{}
+			for _, tx := range s.fixtures.Transactions {
+				if tx.BlockNumber == number {
+					calls, _ := lookup(s.fixtures.InternalTransactions, tx.TransactionHash)
+					internal = append(internal, calls...)
+				}
+			}
+			return paginate(w, q, internal)
+		}
+	}
+	return false
+}
+
+// serveBlockPayload writes the fixture for block number, an empty object for
+// a known block without one, or a 404.
+func (s *Server) serveBlockPayload(w http.ResponseWriter, payloads map[int64]any, number int64) bool {
+	if data, ok := payloads[number]; ok {
+		return writeFound(w, data, true)
+	}
+	_, ok := find(s.fixtures.Blocks, func(b kaiascan.Block) bool { return b.BlockNumber == number })
+	return writeFound(w, map[string]any{}, ok)
+}
+
+func (s *Server) findTransaction(hash string) (kaiascan.Transaction, bool) {
+	return find(s.fixtures.Transactions, func(tx kaiascan.Transaction) bool { return strings.EqualFold(tx.TransactionHash, hash) })
+}
+
+func (s *Server) serveTransactionStatus(w http.ResponseWriter, hash string) bool {
+	tx, ok := s.findTransaction(hash)
+	return writeFound(w, map[string]string{"status": tx.Status}, ok)
+}
+
+func (s *Server) serveTransactions(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	if len(rest) == 0 {
+		return false
+	}
+	hash := rest[0]
+	byHash := func(h string) bool { return strings.EqualFold(h, hash) }
+
+	if len(rest) == 1 {
+		tx, ok := s.findTransaction(hash)
+		return writeFound(w, tx, ok)
+	}
+	if len(rest) != 2 {
+		return false
+	}
+	switch rest[1] {
+	case "status":
+		return s.serveTransactionStatus(w, hash)
+	case "event-logs":
+		signature := first(q, "signature")
+		return paginate(w, q, filter(s.fixtures.EventLogs, func(l kaiascan.EventLog) bool {
+			return byHash(l.TransactionHash) && (signature == "" || l.Signature == signature)
+		}))
+	case "token-transfers":
+		return paginate(w, q, filter(s.fixtures.TokenTransfers, func(t kaiascan.TokenTransfer) bool { return byHash(t.TransactionHash) }))
+	case "nft-transfers":
+		return paginate(w, q, filter(s.fixtures.NftTransfers, func(t kaiascan.NftTransfer) bool { return byHash(t.TransactionHash) }))
+	case "internal-transactions":
+		calls, _ := lookup(s.fixtures.InternalTransactions, hash)
+		if calls == nil {
+			calls = []any{}
+		}
+		return paginate(w, q, calls)
+	case "input-data":
+		if data, ok := lookup(s.fixtures.InputData, hash); ok {
+			return writeFound(w, data, true)
+		}
+		_, ok := s.findTransaction(hash)
+		return writeFound(w, map[string]any{}, ok)
+	}
+	return false
+}
+
+func (s *Server) serveAccounts(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	if len(rest) == 0 {
+		return false
+	}
+	address := rest[0]
+	is := func(a string) bool { return strings.EqualFold(a, address) }
+
+	if len(rest) == 1 {
+		account, ok := find(s.fixtures.Accounts, func(a kaiascan.AccountInfo) bool { return is(a.Address) })
+		return writeFound(w, account, ok)
+	}
+
+	switch strings.Join(rest[1:], "/") {
+	case "transactions":
+		txType := first(q, "type")
+		directions := strings.Split(first(q, "directions"), ",")
+		return paginate(w, q, filter(s.fixtures.Transactions, func(tx kaiascan.Transaction) bool {
+			from := is(tx.From) && (directions[0] == "" || slices.Contains(directions, "from"))
+			to := is(tx.To) && (directions[0] == "" || slices.Contains(directions, "to"))
+			return (from || to) && inRange(q, tx.BlockNumber) && (txType == "" || tx.TransactionType == txType)
+		}))
+	case "fee-paid-transactions":
+		txType := first(q, "type")
+		return paginate(w, q, filter(s.fixtures.Transactions, func(tx kaiascan.Transaction) bool {
+			return is(tx.FeePayer) && inRange(q, tx.BlockNumber) && (txType == "" || tx.TransactionType == txType)
+		}))
+	case "token-transfers":
+		contract := first(q, "contractAddress")
+		return paginate(w, q, filter(s.fixtures.TokenTransfers, func(t kaiascan.TokenTransfer) bool {
+			return (is(t.From) || is(t.To)) && inRange(q, t.BlockNumber) && (contract == "" || strings.EqualFold(t.ContractAddress, contract))
+		}))
+	case "nft-transfers":
+		contract := first(q, "contractAddress")
+		return paginate(w, q, filter(s.fixtures.NftTransfers, func(t kaiascan.NftTransfer) bool {
+			return (is(t.From) || is(t.To)) && inRange(q, t.BlockNumber) && (contract == "" || strings.EqualFold(t.ContractAddress, contract))
+		}))
+	case "event-logs":
+		signature := first(q, "signature")
+		return paginate(w, q, filter(s.fixtures.EventLogs, func(l kaiascan.EventLog) bool {
+			return is(l.Address) && inRange(q, l.BlockNumber) && (signature == "" || l.Signature == signature)
+		}))
+	case "token-balances", "token-details":
+		balances, _ := lookup(s.fixtures.TokenBalances, address)
+		return paginate(w, q, balances)
+	case "nft-balances/kip17", "nft-balances/kip37":
+		kind := kaiascan.NftKind(rest[2])
+		balances, _ := lookup(s.fixtures.NftBalances, address)
+		return paginate(w, q, filter(balances, func(b kaiascan.NftBalance) bool { return b.Kind == kind }))
+	case "key-histories":
+		return paginate(w, q, []any{})
+	}
+	return false
+}
+
+func (s *Server) serveTokens(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	if len(rest) == 0 {
+		info, ok := lookup(s.fixtures.Tokens, first(q, "tokenAddress"))
+		return writeFound(w, info, ok)
+	}
+	if len(rest) != 2 {
+		return false
+	}
+	token := rest[0]
+	if _, ok := lookup(s.fixtures.Tokens, token); !ok {
+		return writeFound(w, nil, false)
+	}
+
+	switch rest[1] {
+	case "holders":
+		holders, _ := lookup(s.fixtures.TokenHolders, token)
+		return paginate(w, q, holders)
+	case "transfers":
+		return paginate(w, q, filter(s.fixtures.TokenTransfers, func(t kaiascan.TokenTransfer) bool {
+			return strings.EqualFold(t.ContractAddress, token) && inRange(q, t.BlockNumber)
+		}))
+	case "burns":
+		burns, _ := lookup(s.fixtures.TokenBurns, token)
+		return paginate(w, q, filter(burns, func(b kaiascan.TokenBurn) bool { return inRange(q, b.BlockNumber) }))
+	}
+	return false
+}
+
+func (s *Server) serveNfts(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	if len(rest) == 0 {
+		address, tokenId := first(q, "nftAddress"), first(q, "tokenId")
+		item, ok := find(s.fixtures.NftItems, func(i kaiascan.NftItem) bool {
+			return strings.EqualFold(i.ContractAddress, address) && i.TokenId == tokenId
+		})
+		return writeFound(w, item, ok)
+	}
+
+	collection := rest[0]
+	info, ok := find(s.fixtures.NftCollections, func(c kaiascan.NftCollection) bool { return strings.EqualFold(c.ContractAddress, collection) })
+	if len(rest) == 1 || !ok {
+		return writeFound(w, info, ok)
+	}
+	if len(rest) != 2 {
+		return false
+	}
+
+	tokenId := first(q, "tokenId")
+	switch rest[1] {
+	case "holders":
+		holders, _ := lookup(s.fixtures.NftHolders, collection)
+		return paginate(w, q, filter(holders, func(h kaiascan.NftHolder) bool { return tokenId == "" || h.TokenId == tokenId }))
+	case "transfers":
+		return paginate(w, q, filter(s.fixtures.NftTransfers, func(t kaiascan.NftTransfer) bool {
+			return strings.EqualFold(t.ContractAddress, collection) && inRange(q, t.BlockNumber) && (tokenId == "" || t.TokenId == tokenId)
+		}))
+	case "inventories":
+		keyword := strings.ToLower(first(q, "keyword"))
+		inventory, _ := lookup(s.fixtures.NftInventories, collection)
+		return paginate(w, q, filter(inventory, func(e kaiascan.NftInventoryEntry) bool {
+			return keyword == "" || strings.Contains(strings.ToLower(e.TokenId), keyword) || strings.Contains(strings.ToLower(e.HolderAddress), keyword)
+		}))
+	}
+	return false
+}
+
+func (s *Server) serveContracts(w http.ResponseWriter, q map[string][]string, rest []string) bool {
+	if len(rest) == 0 {
+		addresses := strings.Split(first(q, "contractAddresses"), ",")
+		return writeFound(w, filter(s.fixtures.Contracts, func(c kaiascan.ContractInfo) bool {
+			return slices.ContainsFunc(addresses, func(a string) bool { return strings.EqualFold(a, c.ContractAddress) })
+		}), true)
+	}
+
+	address := first(q, "contractAddress")
+	switch {
+	case len(rest) == 1 && rest[0] == "creation-code":
+		code, ok := find(s.fixtures.CreationCodes, func(c kaiascan.ContractCreationCode) bool { return strings.EqualFold(c.ContractAddress, address) })
+		return writeFound(w, code, ok)
+	case len(rest) == 1 && rest[0] == "source-code":
+		source, ok := find(s.fixtures.SourceCodes, func(c kaiascan.ContractSourceCode) bool { return strings.EqualFold(c.ContractAddress, address) })
+		return writeFound(w, source, ok)
+	case len(rest) == 1:
+		info, ok := find(s.fixtures.Contracts, func(c kaiascan.ContractInfo) bool { return strings.EqualFold(c.ContractAddress, rest[0]) })
+		return writeFound(w, info, ok)
+	case len(rest) == 2 && rest[1] == "abi":
+		abi, ok := find(s.fixtures.Abis, func(c kaiascan.ContractAbi) bool { return strings.EqualFold(c.ContractAddress, rest[0]) })
+		return writeFound(w, abi, ok)
+	}
+	return false
+}
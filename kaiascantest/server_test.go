@@ -0,0 +1,206 @@
+package kaiascantest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	kaiascan "kaiascan.go"
+)
+
+func newTestServer(t *testing.T) *Server {
+	server := NewServer(DefaultFixtures())
+	restore := server.SetBaseURL()
+	t.Cleanup(func() {
+		restore()
+		server.Close()
+	})
+	return server
+}
+
+func TestServer_Blocks(t *testing.T) {
+	newTestServer(t)
+
+	latest, err := kaiascan.GetLatestBlock()
+	if err != nil || latest.Data.BlockNumber != 10 {
+		t.Fatalf("Unexpected latest block: %+v, %v", latest, err)
+	}
+	block, err := kaiascan.GetBlock(3)
+	if err != nil || block.Data.ParentHash != blockHash(2) {
+		t.Errorf("Unexpected block: %+v, %v", block, err)
+	}
+	byTime, err := kaiascan.GetBlocksByTimestamp(block.Data.Datetime.Unix())
+	if err != nil || len(byTime.Data) != 1 || byTime.Data[0].BlockNumber != 3 {
+		t.Errorf("Unexpected blocks by timestamp: %+v, %v", byTime, err)
+	}
+
+	found, err := kaiascan.BlockAtOrAfter(context.Background(), block.Data.Datetime.Add(500_000_000))
+	if err != nil || found.BlockNumber != 4 {
+		t.Errorf("Unexpected block search result: %+v, %v", found, err)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	newTestServer(t)
+
+	first, err := kaiascan.GetAccountTransactions(Alice, 1, 2, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	paging := first.Data.Paging
+	if paging.TotalCount != 4 || paging.TotalPage != 2 || paging.Last || len(first.Data.Results) != 2 {
+		t.Errorf("Unexpected first page: %+v", first.Data)
+	}
+	second, err := kaiascan.GetAccountTransactions(Alice, 2, 2, nil, nil, nil, []string{"from"})
+	if err != nil || !second.Data.Paging.Last || second.Data.Results[1].TransactionHash != TxHash(5) {
+		t.Errorf("Unexpected second page: %+v, %v", second, err)
+	}
+
+	start, end := 2, 3
+	ranged, err := kaiascan.GetAccountTransactions(Alice, 1, 20, &start, &end, nil, nil)
+	if err != nil || len(ranged.Data.Results) != 2 {
+		t.Errorf("Unexpected block range filter: %+v, %v", ranged, err)
+	}
+
+	fees, err := kaiascan.GetFeePaidTransactions(Relayer, 1, 20, nil, nil, nil)
+	if err != nil || len(fees.Data.Results) != 1 || fees.Data.Results[0].From != Bob {
+		t.Errorf("Unexpected fee paid transactions: %+v, %v", fees, err)
+	}
+}
+
+func TestServer_TokensNftsContracts(t *testing.T) {
+	newTestServer(t)
+
+	holders, err := kaiascan.GetTokenHolders(Token, 1, 20, nil)
+	if err != nil || len(holders.Data.Results) != 2 {
+		t.Errorf("Unexpected token holders: %+v, %v", holders, err)
+	}
+	transfers, err := kaiascan.GetTokenTransfers(Token, 1, 20, nil, nil)
+	if err != nil || len(transfers.Data.Results) != 2 {
+		t.Errorf("Unexpected token transfers: %+v, %v", transfers, err)
+	}
+
+	_, err = kaiascan.GetFungibleToken(Bob)
+	var httpErr *kaiascan.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected a 404 for an unknown token, got %v", err)
+	}
+
+	item, err := kaiascan.GetNftItem(Nft, "1")
+	if err != nil || item.Data.Owner != Alice {
+		t.Errorf("Unexpected NFT item: %+v, %v", item, err)
+	}
+	inventory, err := kaiascan.GetNftInventories(Nft, 1, 20, &[]string{"a11ce"}[0])
+	if err != nil || len(inventory.Data.Results) != 1 {
+		t.Errorf("Unexpected NFT inventory: %+v, %v", inventory, err)
+	}
+
+	contracts, err := kaiascan.GetContractsInfo([]string{Token, Bob})
+	if err != nil || len(contracts.Data) != 1 || !contracts.Data[0].Verified {
+		t.Errorf("Unexpected contracts: %+v, %v", contracts, err)
+	}
+
+	portfolio, err := kaiascan.GetPortfolio(context.Background(), Alice)
+	if err != nil || portfolio.Partial() || len(portfolio.Tokens) != 1 || portfolio.Tokens[0].String() != "750 TUSD" {
+		t.Errorf("Unexpected portfolio: %+v, %v", portfolio, err)
+	}
+}
+
+func TestServer_UntypedEndpoints(t *testing.T) {
+	server := newTestServer(t)
+	server.Update(func(f *Fixtures) {
+		f.BlockBurns[7] = map[string]any{"blockId": 7, "burntFees": "1000"}
+	})
+
+	burns, err := kaiascan.GetLatestBlockBurns(1, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	page := burns.Data.(map[string]any)
+	results := page["results"].([]any)
+	if len(results) != 2 || results[0].(map[string]any)["blockId"] != float64(10) {
+		t.Errorf("Unexpected latest burns: %+v", page)
+	}
+
+	blockBurns, err := kaiascan.GetBlockBurns(7)
+	if err != nil || blockBurns.Data.(map[string]any)["burntFees"] != "1000" {
+		t.Errorf("Unexpected block burns: %+v, %v", blockBurns, err)
+	}
+	rewards, err := kaiascan.GetBlockRewards(2)
+	if err != nil || rewards.Data.(map[string]any)["proposer"] != Relayer {
+		t.Errorf("Unexpected block rewards: %+v, %v", rewards, err)
+	}
+	latestRewards, err := kaiascan.GetLatestBlockRewards(4)
+	if err != nil || latestRewards.Data.(map[string]any)["blockId"] != float64(4) {
+		t.Errorf("Unexpected latest rewards: %+v, %v", latestRewards, err)
+	}
+	if _, err := kaiascan.GetBlockRewards(99); err == nil {
+		t.Error("Expected rewards of an unknown block to be not found")
+	}
+
+	internal, err := kaiascan.GetInternalTransactionsOfBlock(3, 1, 20)
+	if err != nil || len(internal.Data.(map[string]any)["results"].([]any)) != 1 {
+		t.Errorf("Unexpected block internal transactions: %+v, %v", internal, err)
+	}
+	internal, err = kaiascan.GetTransactionInternalTransactions(TxHash(3), 1, 20)
+	if err != nil || len(internal.Data.(map[string]any)["results"].([]any)) != 1 {
+		t.Errorf("Unexpected transaction internal transactions: %+v, %v", internal, err)
+	}
+
+	input, err := kaiascan.GetTransactionInputData(TxHash(3))
+	if err != nil || !strings.HasPrefix(input.Data.(map[string]any)["originalValue"].(string), "0xa9059cbb") {
+		t.Errorf("Unexpected input data: %+v, %v", input, err)
+	}
+	if _, err := kaiascan.GetTransactionInputData(TxHash(2)); err != nil {
+		t.Errorf("Expected input data of a known transaction, got %v", err)
+	}
+
+	status, err := kaiascan.GetTransactionStatus(TxHash(4))
+	if err != nil || status.Data.(map[string]any)["status"] != "Success" {
+		t.Errorf("Unexpected transaction status: %+v, %v", status, err)
+	}
+	receipt, err := kaiascan.GetTransactionReceiptStatus(TxHash(4))
+	if err != nil || receipt.Data.(map[string]any)["status"] != "Success" {
+		t.Errorf("Unexpected receipt status: %+v, %v", receipt, err)
+	}
+}
+
+func TestServer_FaultsAndRaw(t *testing.T) {
+	server := newTestServer(t)
+	server.Update(func(f *Fixtures) {
+		f.Raw["blocks/3/rewards"] = map[string]any{"minted": "6400000000000000000"}
+	})
+	server.Fail("accounts/*/token-balances", Fault{Times: 1})
+	server.Fail("nfts/*", Fault{Code: 1001, Msg: "rate limited"})
+
+	rewards, err := kaiascan.GetBlockRewards(3)
+	if err != nil || rewards.Data.(map[string]any)["minted"] != "6400000000000000000" {
+		t.Errorf("Unexpected raw response: %+v, %v", rewards, err)
+	}
+
+	_, err = kaiascan.GetAccountTokenBalances(Alice, 1, 20)
+	var httpErr *kaiascan.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Expected an injected HTTP error, got %v", err)
+	}
+	if _, err := kaiascan.GetAccountTokenBalances(Alice, 1, 20); err != nil {
+		t.Errorf("Expected the fault to clear after one request, got %v", err)
+	}
+
+	_, err = kaiascan.GetNftInfo(Nft)
+	var apiErr *kaiascan.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 1001 {
+		t.Errorf("Expected an injected API error, got %v", err)
+	}
+	server.ClearFaults()
+	if _, err := kaiascan.GetNftInfo(Nft); err != nil {
+		t.Errorf("Expected no error after clearing faults, got %v", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 5 || requests[0] != "blocks/3/rewards" || !strings.HasPrefix(requests[1], "accounts/"+Alice+"/token-balances?") {
+		t.Errorf("Unexpected request log: %q", requests)
+	}
+}
@@ -0,0 +1,376 @@
+package kaiascan
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"kaiascan.go/units"
+)
+
+type LedgerEntryKind string
+
+const (
+	LedgerTransfer LedgerEntryKind = "transfer"
+	LedgerFee      LedgerEntryKind = "fee"
+	LedgerMint     LedgerEntryKind = "mint"
+	LedgerBurn     LedgerEntryKind = "burn"
+	LedgerSwap     LedgerEntryKind = "swap"
+)
+
+// LedgerEntry is one leg of an account's activity. Amounts are decimal
+// strings in the asset's own unit. A transaction's fee is reported once, on
+// its first entry, and only when the account paid it.
+type LedgerEntry struct {
+	Timestamp       time.Time       `json:"timestamp"`
+	Kind            LedgerEntryKind `json:"type"`
+	Asset           string          `json:"asset"`
+	ContractAddress string          `json:"contractAddress,omitempty"`
+	TokenId         string          `json:"tokenId,omitempty"`
+	AmountIn        string          `json:"amountIn,omitempty"`
+	AmountOut       string          `json:"amountOut,omitempty"`
+	Counterparty    string          `json:"counterparty,omitempty"`
+	Fee             string          `json:"fee,omitempty"`
+	FeePayer        string          `json:"feePayer,omitempty"`
+	TransactionHash string          `json:"transactionHash"`
+	BlockNumber     int64           `json:"blockNumber"`
+}
+
+type Ledger struct {
+	Address string        `json:"address"`
+	Entries []LedgerEntry `json:"entries"`
+}
+
+type LedgerOptions struct {
+	BlockNumberStart *int
+	BlockNumberEnd   *int
+	// API is where history is fetched from. A nil API means a Client.
+	API interface {
+		AccountsAPI
+		TokensAPI
+		NftsAPI
+	}
+}
+
+type ledgerLeg struct {
+	LedgerEntry
+	order int
+}
+
+// BuildLedger collects the native, token and NFT movements and fees of an
+// account and classifies them. Transfers from the zero address are mints,
+// transfers to the zero or dead address are burns, and transactions that
+// move different assets both in and out are swaps. Fees are charged for the
+// account's own transactions and for those it sponsored through
+// GetFeePaidTransactions, split by fee delegation between sender and fee
+// payer.
+func BuildLedger(ctx context.Context, accountAddress string, opts LedgerOptions) (*Ledger, error) {
+	if accountAddress == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+	self := strings.ToLower(accountAddress)
+	start, end := opts.BlockNumberStart, opts.BlockNumberEnd
+	api := apiOrDefault(opts.API)
+
+	transactions, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[Transaction]], error) {
+		return api.GetAccountTransactions(accountAddress, page, size, start, end, nil, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching transactions: %w", err)
+	}
+	feePaid, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[Transaction]], error) {
+		return api.GetFeePaidTransactions(accountAddress, page, size, start, end, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching fee paid transactions: %w", err)
+	}
+	tokenTransfers, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenTransfer]], error) {
+		return api.GetAccountTokenTransfers(accountAddress, page, size, nil, start, end)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token transfers: %w", err)
+	}
+	nftTransfers, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftTransfer]], error) {
+		return api.GetAccountNftTransfers(accountAddress, page, size, nil, start, end)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching NFT transfers: %w", err)
+	}
+
+	var legs []ledgerLeg
+	add := func(entry LedgerEntry, from string, to string, amount string, order int) {
+		from, to = strings.ToLower(from), strings.ToLower(to)
+		switch {
+		case from == self && to == self:
+			return
+		case from == self:
+			entry.AmountOut = amount
+			entry.Counterparty = to
+			if to == zeroAddress || to == deadAddress {
+				entry.Kind = LedgerBurn
+			}
+		case to == self:
+			entry.AmountIn = amount
+			entry.Counterparty = from
+			if from == zeroAddress {
+				entry.Kind = LedgerMint
+			}
+		default:
+			return
+		}
+		legs = append(legs, ledgerLeg{LedgerEntry: entry, order: order})
+	}
+
+	feePayers := map[string]string{}
+	for _, tx := range transactions {
+		feePayers[strings.ToLower(tx.TransactionHash)] = feePayerOf(tx)
+		if tx.Amount.IsZero() || strings.HasPrefix(strings.ToLower(tx.Status), "fail") {
+			continue
+		}
+		entry := LedgerEntry{
+			Timestamp:       tx.Datetime,
+			Kind:            LedgerTransfer,
+			Asset:           units.KAIA.String(),
+			TransactionHash: tx.TransactionHash,
+			BlockNumber:     tx.BlockNumber,
+		}
+		add(entry, tx.From, tx.To, units.FormatExact(tx.Amount.Big(), units.KAIA), -1)
+	}
+
+	tokens := NewTokenMetadataCache()
+	tokens.API = api
+	for _, t := range tokenTransfers {
+		amount, err := tokens.FormatAmount(t.ContractAddress, t.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching token %s: %w", t.ContractAddress, err)
+		}
+		asset := amount.Symbol
+		if asset == "" {
+			asset = t.ContractAddress
+		}
+		entry := LedgerEntry{
+			Timestamp:       t.Datetime,
+			Kind:            LedgerTransfer,
+			Asset:           asset,
+			ContractAddress: t.ContractAddress,
+			TransactionHash: t.TransactionHash,
+			BlockNumber:     t.BlockNumber,
+		}
+		add(entry, t.From, t.To, amount.Value(), t.LogIndex)
+	}
+
+	collections := map[string]string{}
+	for _, t := range nftTransfers {
+		key := strings.ToLower(t.ContractAddress)
+		symbol, ok := collections[key]
+		if !ok {
+			resp, err := api.GetNftInfo(t.ContractAddress)
+			switch {
+			case err == nil:
+				symbol = resp.Data.Symbol
+			case !isNotFound(err):
+				return nil, fmt.Errorf("error fetching NFT collection %s: %w", t.ContractAddress, err)
+			}
+			if symbol == "" {
+				symbol = t.ContractAddress
+			}
+			collections[key] = symbol
+		}
+		quantity := t.Quantity.String()
+		if t.Quantity.IsZero() {
+			quantity = "1"
+		}
+		entry := LedgerEntry{
+			Timestamp:       t.Datetime,
+			Kind:            LedgerTransfer,
+			Asset:           symbol,
+			ContractAddress: t.ContractAddress,
+			TokenId:         t.TokenId,
+			TransactionHash: t.TransactionHash,
+			BlockNumber:     t.BlockNumber,
+		}
+		add(entry, t.From, t.To, quantity, t.LogIndex)
+	}
+
+	markSwaps(legs)
+
+	// Attach each fee to the first leg of its transaction, or to a fee-only
+	// entry when the account moved nothing, for example when it sponsored
+	// someone else's transaction.
+	first := map[string]int{}
+	sortLedgerLegs(legs)
+	for i, leg := range legs {
+		key := strings.ToLower(leg.TransactionHash)
+		if _, ok := first[key]; !ok {
+			first[key] = i
+		}
+		legs[i].FeePayer = feePayers[key]
+	}
+	charged := map[string]bool{}
+	for _, tx := range slices.Concat(transactions, feePaid) {
+		key := strings.ToLower(tx.TransactionHash)
+		if charged[key] {
+			continue
+		}
+		charged[key] = true
+		fee := feeShare(tx, self)
+		if fee.Sign() == 0 {
+			continue
+		}
+		formatted := units.FormatExact(fee, units.KAIA)
+		if i, ok := first[key]; ok {
+			legs[i].Fee = formatted
+			continue
+		}
+		counterparty := strings.ToLower(tx.From)
+		if counterparty == self {
+			counterparty = strings.ToLower(tx.To)
+		}
+		legs = append(legs, ledgerLeg{LedgerEntry: LedgerEntry{
+			Timestamp:       tx.Datetime,
+			Kind:            LedgerFee,
+			Asset:           units.KAIA.String(),
+			Counterparty:    counterparty,
+			Fee:             formatted,
+			FeePayer:        feePayerOf(tx),
+			TransactionHash: tx.TransactionHash,
+			BlockNumber:     tx.BlockNumber,
+		}, order: -2})
+	}
+	sortLedgerLegs(legs)
+
+	ledger := &Ledger{Address: accountAddress, Entries: make([]LedgerEntry, len(legs))}
+	for i, leg := range legs {
+		ledger.Entries[i] = leg.LedgerEntry
+	}
+	return ledger, nil
+}
+
+// markSwaps reclassifies the legs of transactions that send and receive
+// different assets.
+func markSwaps(legs []ledgerLeg) {
+	type assets struct{ in, out map[string]bool }
+	byTx := map[string]*assets{}
+	for _, leg := range legs {
+		key := strings.ToLower(leg.TransactionHash)
+		if byTx[key] == nil {
+			byTx[key] = &assets{in: map[string]bool{}, out: map[string]bool{}}
+		}
+		asset := strings.ToLower(leg.ContractAddress + "/" + leg.TokenId)
+		if leg.AmountIn != "" {
+			byTx[key].in[asset] = true
+		} else {
+			byTx[key].out[asset] = true
+		}
+	}
+
+	for i, leg := range legs {
+		a := byTx[strings.ToLower(leg.TransactionHash)]
+		if len(a.in) == 0 || len(a.out) == 0 {
+			continue
+		}
+		moved := map[string]bool{}
+		for asset := range a.in {
+			moved[asset] = true
+		}
+		for asset := range a.out {
+			moved[asset] = true
+		}
+		if len(moved) > 1 {
+			legs[i].Kind = LedgerSwap
+		}
+	}
+}
+
+func sortLedgerLegs(legs []ledgerLeg) {
+	sort.SliceStable(legs, func(i, j int) bool {
+		a, b := legs[i], legs[j]
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		if !a.Timestamp.Equal(b.Timestamp) {
+			return a.Timestamp.Before(b.Timestamp)
+		}
+		if a.TransactionHash != b.TransactionHash {
+			return a.TransactionHash < b.TransactionHash
+		}
+		return a.order < b.order
+	})
+}
+
+func feePayerOf(tx Transaction) string {
+	if tx.FeePayer != "" {
+		return strings.ToLower(tx.FeePayer)
+	}
+	return strings.ToLower(tx.From)
+}
+
+// feeShare returns the part of a transaction's fee paid by address. With
+// partial fee delegation the fee payer covers FeeRatio percent and the
+// sender the rest.
+func feeShare(tx Transaction, address string) *big.Int {
+	fee := tx.TransactionFee.Big()
+	payer, sender := feePayerOf(tx), strings.ToLower(tx.From)
+	if payer == sender {
+		if sender == address {
+			return fee
+		}
+		return new(big.Int)
+	}
+
+	ratio := int64(tx.FeeRatio)
+	if ratio <= 0 || ratio > 100 {
+		ratio = 100
+	}
+	payerPart := new(big.Int).Div(new(big.Int).Mul(fee, big.NewInt(ratio)), big.NewInt(100))
+	switch address {
+	case payer:
+		return payerPart
+	case sender:
+		return new(big.Int).Sub(fee, payerPart)
+	default:
+		return new(big.Int)
+	}
+}
+
+// WriteCSV writes the ledger in the generic "universal" layout most tax tools
+// import: one row per leg with sent, received and fee columns.
+func (l *Ledger) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"Date", "Type", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency",
+		"Fee Amount", "Fee Currency", "Counterparty", "Fee Payer", "Contract Address", "Token ID", "TxHash",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, e := range l.Entries {
+		var sentCurrency, receivedCurrency, feeCurrency string
+		if e.AmountOut != "" {
+			sentCurrency = e.Asset
+		}
+		if e.AmountIn != "" {
+			receivedCurrency = e.Asset
+		}
+		if e.Fee != "" {
+			feeCurrency = units.KAIA.String()
+		}
+		row := []string{
+			e.Timestamp.UTC().Format("2006-01-02 15:04:05"), string(e.Kind),
+			e.AmountOut, sentCurrency, e.AmountIn, receivedCurrency,
+			e.Fee, feeCurrency, e.Counterparty, e.FeePayer, e.ContractAddress, e.TokenId, e.TransactionHash,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
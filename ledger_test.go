@@ -0,0 +1,117 @@
+package kaiascan
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"kaiascan.go/units"
+)
+
+func TestBuildLedger(t *testing.T) {
+	at := func(block int64) time.Time { return time.Unix(1700000000+block, 0).UTC() }
+	kaia := func(s string) units.Amount {
+		v, _ := units.ToKei(s, units.KAIA)
+		return units.NewAmount(v)
+	}
+
+	transactions := []Transaction{
+		{TransactionHash: "0xa", BlockNumber: 10, Datetime: at(10), From: "0xSelf", To: "0xbob", Amount: kaia("1"), TransactionFee: kaia("0.001")},
+		{TransactionHash: "0xb", BlockNumber: 20, Datetime: at(20), From: "0xself", To: "0xdex", TransactionFee: kaia("0.002")},
+		{TransactionHash: "0xc", BlockNumber: 30, Datetime: at(30), From: "0xself", To: "0xpunks", TransactionFee: kaia("0.003"), FeePayer: "0xsponsor", FeeRatio: 100},
+		{TransactionHash: "0xe", BlockNumber: 50, Datetime: at(50), From: "0xself", To: "0xusdt", TransactionFee: kaia("0.0005")},
+		{TransactionHash: "0xf", BlockNumber: 60, Datetime: at(60), From: "0xself", To: "0xbob", Amount: kaia("5"), Status: "Fail", TransactionFee: kaia("0.001")},
+	}
+	feePaid := []Transaction{
+		{TransactionHash: "0xd", BlockNumber: 40, Datetime: at(40), From: "0xcarol", To: "0xgame", TransactionFee: amountOf(1000), FeePayer: "0xself", FeeRatio: 30},
+	}
+	tokenTransfers := []TokenTransfer{
+		{TransactionHash: "0xb", LogIndex: 1, BlockNumber: 20, Datetime: at(20), From: "0xself", To: "0xdex", ContractAddress: "0xusdt", Amount: amountOf(1500000)},
+		{TransactionHash: "0xb", LogIndex: 2, BlockNumber: 20, Datetime: at(20), From: "0xdex", To: "0xself", ContractAddress: "0xmystery", Amount: amountOf(100)},
+		{TransactionHash: "0xe", LogIndex: 0, BlockNumber: 50, Datetime: at(50), From: "0xself", To: zeroAddress, ContractAddress: "0xusdt", Amount: amountOf(250000)},
+	}
+	nftTransfers := []NftTransfer{
+		{TransactionHash: "0xc", LogIndex: 0, BlockNumber: 30, Datetime: at(30), From: zeroAddress, To: "0xself", ContractAddress: "0xpunks", TokenId: "7"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.Path
+		switch {
+		case strings.HasSuffix(p, "/accounts/0xself/transactions"):
+			w.Write(mockApiResponse(Page[Transaction]{Paging: Paging{Last: true}, Results: transactions}, 0, "Success"))
+		case strings.HasSuffix(p, "/accounts/0xself/fee-paid-transactions"):
+			w.Write(mockApiResponse(Page[Transaction]{Paging: Paging{Last: true}, Results: feePaid}, 0, "Success"))
+		case strings.HasSuffix(p, "/accounts/0xself/token-transfers"):
+			w.Write(mockApiResponse(Page[TokenTransfer]{Paging: Paging{Last: true}, Results: tokenTransfers}, 0, "Success"))
+		case strings.HasSuffix(p, "/accounts/0xself/nft-transfers"):
+			w.Write(mockApiResponse(Page[NftTransfer]{Paging: Paging{Last: true}, Results: nftTransfers}, 0, "Success"))
+		case strings.HasSuffix(p, "/nfts/0xpunks"):
+			w.Write(mockApiResponse(NftCollection{Symbol: "PUNK"}, 0, "Success"))
+		case p == "/api/v1/tokens":
+			if r.URL.Query().Get("tokenAddress") == "0xmystery" {
+				w.Write(mockApiResponse(TokenInfo{}, 404, "token not found"))
+				return
+			}
+			w.Write(mockApiResponse(TokenInfo{Symbol: "USDT", Decimal: 6}, 0, "Success"))
+		default:
+			t.Errorf("Unexpected API path: %s", p)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	ledger, err := BuildLedger(context.Background(), "0xself", LedgerOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ledger.WriteCSV(&buf); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := []string{
+		"Date,Type,Sent Amount,Sent Currency,Received Amount,Received Currency,Fee Amount,Fee Currency,Counterparty,Fee Payer,Contract Address,Token ID,TxHash",
+		"2023-11-14 22:13:30,transfer,1,KAIA,,,0.001,KAIA,0xbob,0xself,,,0xa",
+		"2023-11-14 22:13:40,swap,1.5,USDT,,,0.002,KAIA,0xdex,0xself,0xusdt,,0xb",
+		"2023-11-14 22:13:40,swap,,,100,0xmystery,,,0xdex,0xself,0xmystery,,0xb",
+		"2023-11-14 22:13:50,mint,,,1,PUNK,,,0x0000000000000000000000000000000000000000,0xsponsor,0xpunks,7,0xc",
+		"2023-11-14 22:14:00,fee,,,,,0.0000000000000003,KAIA,0xcarol,0xself,,,0xd",
+		"2023-11-14 22:14:10,burn,0.25,USDT,,,0.0005,KAIA,0x0000000000000000000000000000000000000000,0xself,0xusdt,,0xe",
+		"2023-11-14 22:14:20,fee,,,,,0.001,KAIA,0xbob,0xself,,,0xf",
+	}
+	got := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(got) != len(want) {
+		t.Fatalf("Unexpected ledger:\n%s", buf.String())
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Row %d:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBuildLedger_CollectionLookupError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.Path
+		switch {
+		case strings.HasSuffix(p, "/accounts/0xself/nft-transfers"):
+			transfers := []NftTransfer{{TransactionHash: "0xc", From: zeroAddress, To: "0xself", ContractAddress: "0xpunks", TokenId: "7"}}
+			w.Write(mockApiResponse(Page[NftTransfer]{Paging: Paging{Last: true}, Results: transfers}, 0, "Success"))
+		case strings.HasSuffix(p, "/nfts/0xpunks"):
+			w.Write(mockApiResponse(NftCollection{}, 500, "Internal error"))
+		default:
+			w.Write(mockApiResponse(Page[Transaction]{Paging: Paging{Last: true}}, 0, "Success"))
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	if _, err := BuildLedger(context.Background(), "0xself", LedgerOptions{}); err == nil {
+		t.Error("Expected a failed collection lookup to be returned instead of falling back to the address")
+	}
+}
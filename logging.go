@@ -0,0 +1,129 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogLevels selects the level used for successful and failed requests.
+type LogLevels struct {
+	Success slog.Level
+	Failure slog.Level
+}
+
+var DefaultLogLevels = LogLevels{Success: slog.LevelDebug, Failure: slog.LevelWarn}
+
+var (
+	logger    *slog.Logger
+	logLevels = DefaultLogLevels
+)
+
+// redactedParams are query parameters whose values are never logged.
+var redactedParams = []string{"apikey", "api_key", "key", "token", "access_token", "secret", "password"}
+
+var (
+	addressSegment = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	hashSegment    = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+	hexSegment     = regexp.MustCompile(`^0x[0-9a-fA-F]*$`)
+	numberSegment  = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ConfigureLogger enables structured logging of every API request. Each
+// record carries the method, endpoint template, redacted query, status,
+// duration and response size. A nil logger disables logging.
+func ConfigureLogger(l *slog.Logger, levels LogLevels) {
+	logger = l
+	logLevels = levels
+}
+
+func logRequest(req *http.Request, status int, size int, duration time.Duration, err error) {
+	if logger == nil {
+		return
+	}
+	level := logLevels.Success
+	if err != nil {
+		level = logLevels.Failure
+	}
+	ctx := context.Background()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpointTemplate(req.URL.Path)),
+		slog.String("query", redactQuery(req.URL.Query())),
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+		slog.Int("bytes", size),
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		attrs = append(attrs, slog.Int("code", apiErr.Code))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redactError(err).Error()))
+	}
+	logger.LogAttrs(ctx, level, "kaiascan request", attrs...)
+}
+
+// endpointTemplate replaces addresses, hashes and numbers in a request path
+// with placeholders so that records for the same endpoint group together.
+func endpointTemplate(path string) string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		switch {
+		case segment == "":
+			continue
+		case addressSegment.MatchString(segment):
+			segment = "{address}"
+		case hashSegment.MatchString(segment):
+			segment = "{hash}"
+		case hexSegment.MatchString(segment), numberSegment.MatchString(segment):
+			segment = "{number}"
+		}
+		segments = append(segments, segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func redactQuery(query url.Values) string {
+	for name, values := range query {
+		if containsFold(redactedParams, name) {
+			for i := range values {
+				values[i] = "REDACTED"
+			}
+		}
+	}
+	return query.Encode()
+}
+
+// redactError strips the query from URLs embedded in transport errors.
+func redactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return errors.New(urlErr.Op + ": " + urlErr.Err.Error())
+	}
+	u.User = nil
+	u.RawQuery = redactQuery(u.Query())
+	return &url.Error{Op: urlErr.Op, URL: u.String(), Err: urlErr.Err}
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package kaiascan
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConfigureLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks"):
+			w.Write(mockApiResponse(Block{BlockNumber: 42}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/transactions"):
+			w.Write(mockApiResponse(Block{}, 1, "Invalid address"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	var buf bytes.Buffer
+	ConfigureLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})), DefaultLogLevels)
+	defer ConfigureLogger(nil, DefaultLogLevels)
+
+	if _, err := GetBlock(42); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	address := "0x" + strings.Repeat("ab", 20)
+	if _, err := fetchApi[any](BASE_URL + accountEndpoint + "/" + address + "/transactions?page=1&apiKey=secret"); err == nil {
+		t.Fatal("Expected an API error")
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Invalid log record %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+
+	ok := records[0]
+	if ok["level"] != "DEBUG" || ok["method"] != "GET" || ok["endpoint"] != "api/v1/blocks" || ok["query"] != "blockNumber=42" || ok["status"] != float64(200) {
+		t.Errorf("Unexpected success record: %v", ok)
+	}
+	if ok["bytes"].(float64) == 0 {
+		t.Errorf("Expected response size to be logged: %v", ok)
+	}
+
+	failed := records[1]
+	if failed["level"] != "WARN" || failed["endpoint"] != "api/v1/accounts/{address}/transactions" || failed["code"] != float64(1) {
+		t.Errorf("Unexpected failure record: %v", failed)
+	}
+	if failed["query"] != "apiKey=REDACTED&page=1" {
+		t.Errorf("Expected redacted query, got %v", failed["query"])
+	}
+	if strings.Contains(buf.String(), "secret") {
+		t.Error("Secret leaked into logs")
+	}
+}
+
+func TestConfigureLogger_Levels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(mockApiResponse(Block{}, 0, "Success"))
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	var buf bytes.Buffer
+	ConfigureLogger(slog.New(slog.NewTextHandler(&buf, nil)), DefaultLogLevels)
+	defer ConfigureLogger(nil, DefaultLogLevels)
+
+	if _, err := GetLatestBlock(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected debug records to be filtered, got %q", buf.String())
+	}
+
+	ConfigureLogger(slog.New(slog.NewTextHandler(&buf, nil)), LogLevels{Success: slog.LevelInfo, Failure: slog.LevelError})
+	if _, err := GetLatestBlock(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(buf.String(), "level=INFO") || !strings.Contains(buf.String(), "endpoint=api/v1/blocks/latest") {
+		t.Errorf("Unexpected record: %q", buf.String())
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/blocks/latest":                                   "api/v1/blocks/latest",
+		"//api/v1/blocks/123/transactions":                        "api/v1/blocks/{number}/transactions",
+		"/api/v1/transactions/0x" + strings.Repeat("1", 64):       "api/v1/transactions/{hash}",
+		"/api/v1/nfts/0x" + strings.Repeat("a", 40) + "/tokens/7": "api/v1/nfts/{address}/tokens/{number}",
+	}
+	for path, want := range tests {
+		if got := endpointTemplate(path); got != want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package kaiascan
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestMetric describes a single completed API request.
+type RequestMetric struct {
+	Method   string
+	Endpoint string
+	Status   int
+	Duration time.Duration
+	Bytes    int
+	// ErrorCode is empty on success and otherwise names the failure: the
+	// OAPI error code, "http_<status>", "transport" or "decode".
+	ErrorCode string
+}
+
+// Metrics receives a RequestMetric for every API request.
+type Metrics interface {
+	ObserveRequest(m RequestMetric)
+}
+
+var metrics Metrics
+
+// ConfigureMetrics reports every API request to m. A nil value disables
+// reporting.
+func ConfigureMetrics(m Metrics) {
+	metrics = m
+}
+
+func observeRequest(req *http.Request, status int, size int, duration time.Duration, err error) {
+	logRequest(req, status, size, duration, err)
+	if metrics == nil {
+		return
+	}
+	metrics.ObserveRequest(RequestMetric{
+		Method:    req.Method,
+		Endpoint:  endpointTemplate(req.URL.Path),
+		Status:    status,
+		Duration:  duration,
+		Bytes:     size,
+		ErrorCode: errorCode(err),
+	})
+}
+
+func errorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return strconv.Itoa(apiErr.Code)
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return fmt.Sprintf("http_%d", httpErr.StatusCode)
+	}
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return "decode"
+	}
+	return "transport"
+}
+
+// DefaultLatencyBuckets are the histogram upper bounds, in seconds, used by
+// NewMetricsCollector.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// EndpointMetrics holds the counters collected for one method and endpoint.
+type EndpointMetrics struct {
+	Method   string
+	Endpoint string
+	Requests map[int]int64    // by HTTP status, 0 for transport errors
+	Errors   map[string]int64 // by RequestMetric.ErrorCode
+	Bytes    int64
+	Latency  Histogram
+}
+
+// Histogram is a cumulative latency histogram. Counts[i] is the number of
+// observations less than or equal to Buckets[i].
+type Histogram struct {
+	Buckets []float64
+	Counts  []int64
+	Sum     float64
+	Count   int64
+}
+
+func (h *Histogram) observe(seconds float64) {
+	for i, bound := range h.Buckets {
+		if seconds <= bound {
+			h.Counts[i]++
+		}
+	}
+	h.Sum += seconds
+	h.Count++
+}
+
+// MetricsCollector is an in-memory Metrics implementation. It is safe for
+// concurrent use and serves its contents in the Prometheus text format.
+type MetricsCollector struct {
+	mu        sync.Mutex
+	buckets   []float64
+	endpoints map[[2]string]*EndpointMetrics
+}
+
+var _ Metrics = (*MetricsCollector)(nil)
+var _ http.Handler = (*MetricsCollector)(nil)
+
+// NewMetricsCollector creates a collector using the given histogram bucket
+// bounds in seconds, or DefaultLatencyBuckets if none are given.
+func NewMetricsCollector(buckets ...float64) *MetricsCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &MetricsCollector{buckets: buckets, endpoints: map[[2]string]*EndpointMetrics{}}
+}
+
+func (c *MetricsCollector) ObserveRequest(m RequestMetric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := [2]string{m.Method, m.Endpoint}
+	e, ok := c.endpoints[key]
+	if !ok {
+		e = &EndpointMetrics{
+			Method:   m.Method,
+			Endpoint: m.Endpoint,
+			Requests: map[int]int64{},
+			Errors:   map[string]int64{},
+			Latency:  Histogram{Buckets: c.buckets, Counts: make([]int64, len(c.buckets))},
+		}
+		c.endpoints[key] = e
+	}
+	e.Requests[m.Status]++
+	if m.ErrorCode != "" {
+		e.Errors[m.ErrorCode]++
+	}
+	e.Bytes += int64(m.Bytes)
+	e.Latency.observe(m.Duration.Seconds())
+}
+
+// Snapshot returns a copy of the collected metrics sorted by endpoint and
+// method.
+func (c *MetricsCollector) Snapshot() []EndpointMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make([]EndpointMetrics, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		copied := *e
+		copied.Requests = make(map[int]int64, len(e.Requests))
+		for status, n := range e.Requests {
+			copied.Requests[status] = n
+		}
+		copied.Errors = make(map[string]int64, len(e.Errors))
+		for code, n := range e.Errors {
+			copied.Errors[code] = n
+		}
+		copied.Latency.Counts = append([]int64(nil), e.Latency.Counts...)
+		snapshot = append(snapshot, copied)
+	}
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].Endpoint != snapshot[j].Endpoint {
+			return snapshot[i].Endpoint < snapshot[j].Endpoint
+		}
+		return snapshot[i].Method < snapshot[j].Method
+	})
+	return snapshot
+}
+
+// Reset discards everything collected so far.
+func (c *MetricsCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endpoints = map[[2]string]*EndpointMetrics{}
+}
+
+// WritePrometheus writes the collected metrics in the Prometheus text
+// exposition format.
+func (c *MetricsCollector) WritePrometheus(w io.Writer) error {
+	snapshot := c.Snapshot()
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "# HELP kaiascan_requests_total Total number of API requests.")
+	fmt.Fprintln(bw, "# TYPE kaiascan_requests_total counter")
+	for _, e := range snapshot {
+		for _, status := range sortedKeys(e.Requests) {
+			fmt.Fprintf(bw, "kaiascan_requests_total{%s,status=\"%d\"} %d\n", endpointLabels(e), status, e.Requests[status])
+		}
+	}
+
+	fmt.Fprintln(bw, "# HELP kaiascan_request_errors_total Total number of failed API requests by error code.")
+	fmt.Fprintln(bw, "# TYPE kaiascan_request_errors_total counter")
+	for _, e := range snapshot {
+		for _, code := range sortedKeys(e.Errors) {
+			fmt.Fprintf(bw, "kaiascan_request_errors_total{%s,code=\"%s\"} %d\n", endpointLabels(e), escapeLabel(code), e.Errors[code])
+		}
+	}
+
+	fmt.Fprintln(bw, "# HELP kaiascan_response_bytes_total Total size of API response bodies in bytes.")
+	fmt.Fprintln(bw, "# TYPE kaiascan_response_bytes_total counter")
+	for _, e := range snapshot {
+		fmt.Fprintf(bw, "kaiascan_response_bytes_total{%s} %d\n", endpointLabels(e), e.Bytes)
+	}
+
+	fmt.Fprintln(bw, "# HELP kaiascan_request_duration_seconds API request latency.")
+	fmt.Fprintln(bw, "# TYPE kaiascan_request_duration_seconds histogram")
+	for _, e := range snapshot {
+		labels := endpointLabels(e)
+		for i, bound := range e.Latency.Buckets {
+			fmt.Fprintf(bw, "kaiascan_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels, formatFloat(bound), e.Latency.Counts[i])
+		}
+		fmt.Fprintf(bw, "kaiascan_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, e.Latency.Count)
+		fmt.Fprintf(bw, "kaiascan_request_duration_seconds_sum{%s} %s\n", labels, formatFloat(e.Latency.Sum))
+		fmt.Fprintf(bw, "kaiascan_request_duration_seconds_count{%s} %d\n", labels, e.Latency.Count)
+	}
+	return bw.Flush()
+}
+
+func (c *MetricsCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WritePrometheus(w)
+}
+
+func endpointLabels(e EndpointMetrics) string {
+	return fmt.Sprintf("method=\"%s\",endpoint=\"%s\"", escapeLabel(e.Method), escapeLabel(e.Endpoint))
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func sortedKeys[K int | string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
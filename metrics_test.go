@@ -0,0 +1,104 @@
+package kaiascan
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigureMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(Block{BlockNumber: 42}, 0, "Success"))
+		case strings.Contains(r.URL.Path, "/accounts/"):
+			w.Write(mockApiResponse(AccountInfo{}, 1, "Invalid address"))
+		case strings.HasSuffix(r.URL.Path, "/blocks"):
+			w.Write([]byte("not json"))
+		case strings.Contains(r.URL.Path, "/transactions/"):
+			w.Write([]byte(`{"code":0,"msg":"Success","data":{"amount":"abc"}}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	collector := NewMetricsCollector()
+	ConfigureMetrics(collector)
+	defer ConfigureMetrics(nil)
+
+	GetLatestBlock()
+	GetLatestBlock()
+	GetAccountInfo("0x" + strings.Repeat("ab", 20))
+	GetBlock(1)
+	GetContractInfo("0x" + strings.Repeat("cd", 20))
+	GetTransaction("0x" + strings.Repeat("ef", 32))
+
+	snapshot := collector.Snapshot()
+	if len(snapshot) != 5 {
+		t.Fatalf("Expected 5 endpoints, got %d: %+v", len(snapshot), snapshot)
+	}
+	byEndpoint := map[string]EndpointMetrics{}
+	for _, e := range snapshot {
+		byEndpoint[e.Endpoint] = e
+	}
+
+	latest := byEndpoint["api/v1/blocks/latest"]
+	if latest.Requests[200] != 2 || len(latest.Errors) != 0 || latest.Latency.Count != 2 || latest.Bytes == 0 {
+		t.Errorf("Unexpected latest block metrics: %+v", latest)
+	}
+	if got := byEndpoint["api/v1/accounts/{address}"].Errors["1"]; got != 1 {
+		t.Errorf("Expected one OAPI error, got %d", got)
+	}
+	if got := byEndpoint["api/v1/blocks"].Errors["decode"]; got != 1 {
+		t.Errorf("Expected one decode error, got %d", got)
+	}
+	if got := byEndpoint["api/v1/transactions/{hash}"].Errors["decode"]; got != 1 {
+		t.Errorf("Expected a malformed amount to count as a decode error, got %d", got)
+	}
+	if got := byEndpoint["api/v1/contracts/{address}"].Errors["http_503"]; got != 1 {
+		t.Errorf("Expected one HTTP error, got %d", got)
+	}
+
+	collector.Reset()
+	if len(collector.Snapshot()) != 0 {
+		t.Error("Expected Reset to discard metrics")
+	}
+}
+
+func TestMetricsCollector_ServeHTTP(t *testing.T) {
+	collector := NewMetricsCollector(1, 0.1)
+	collector.ObserveRequest(RequestMetric{Method: "GET", Endpoint: "api/v1/blocks/latest", Status: 200, Duration: 50 * time.Millisecond, Bytes: 10})
+	collector.ObserveRequest(RequestMetric{Method: "GET", Endpoint: "api/v1/blocks/latest", Status: 200, Duration: 2 * time.Second, Bytes: 10})
+	collector.ObserveRequest(RequestMetric{Method: "GET", Endpoint: `api/v1/"odd"`, Status: 0, Duration: time.Second, ErrorCode: "transport"})
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if got := recorder.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Errorf("Unexpected content type %q", got)
+	}
+	body, _ := io.ReadAll(recorder.Body)
+
+	expected := []string{
+		"# TYPE kaiascan_requests_total counter",
+		`kaiascan_requests_total{method="GET",endpoint="api/v1/blocks/latest",status="200"} 2`,
+		`kaiascan_requests_total{method="GET",endpoint="api/v1/\"odd\"",status="0"} 1`,
+		`kaiascan_request_errors_total{method="GET",endpoint="api/v1/\"odd\"",code="transport"} 1`,
+		`kaiascan_response_bytes_total{method="GET",endpoint="api/v1/blocks/latest"} 20`,
+		"# TYPE kaiascan_request_duration_seconds histogram",
+		`kaiascan_request_duration_seconds_bucket{method="GET",endpoint="api/v1/blocks/latest",le="0.1"} 1`,
+		`kaiascan_request_duration_seconds_bucket{method="GET",endpoint="api/v1/blocks/latest",le="1"} 1`,
+		`kaiascan_request_duration_seconds_bucket{method="GET",endpoint="api/v1/blocks/latest",le="+Inf"} 2`,
+		`kaiascan_request_duration_seconds_sum{method="GET",endpoint="api/v1/blocks/latest"} 2.05`,
+		`kaiascan_request_duration_seconds_count{method="GET",endpoint="api/v1/blocks/latest"} 2`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(string(body), line+"\n") {
+			t.Errorf("Expected line %q in:\n%s", line, body)
+		}
+	}
+}
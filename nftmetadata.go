@@ -0,0 +1,218 @@
+package kaiascan
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrMetadataTooLarge = errors.New("NFT metadata exceeds size limit")
+
+type MetadataResolver struct {
+	IPFSGateways   []string
+	ArweaveGateway string
+	HTTPClient     *http.Client
+	Timeout        time.Duration
+	MaxSize        int64
+
+	mu    sync.Mutex
+	cache map[string]*NftMetadata
+}
+
+func NewMetadataResolver() *MetadataResolver {
+	return &MetadataResolver{
+		IPFSGateways:   []string{"https://ipfs.io/ipfs/"},
+		ArweaveGateway: "https://arweave.net/",
+		HTTPClient:     &http.Client{},
+		Timeout:        10 * time.Second,
+		MaxSize:        1 << 20,
+		cache:          map[string]*NftMetadata{},
+	}
+}
+
+// ResolveItem resolves the metadata of an NFT item from its token URI,
+// substituting the KIP-37 {id} placeholder with the item's decimal token ID.
+func (r *MetadataResolver) ResolveItem(ctx context.Context, item *NftItem) (*NftMetadata, error) {
+	if item.TokenUri == "" {
+		return nil, fmt.Errorf("token %s of %s has no token URI", item.TokenId, item.ContractAddress)
+	}
+
+	uri := item.TokenUri
+	if strings.Contains(uri, "{id}") {
+		id, ok := new(big.Int).SetString(item.TokenId, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid token ID %q", item.TokenId)
+		}
+		uri = strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+	}
+	return r.Resolve(ctx, uri)
+}
+
+func (r *MetadataResolver) Resolve(ctx context.Context, uri string) (*NftMetadata, error) {
+	uri = strings.TrimSpace(uri)
+
+	r.mu.Lock()
+	cached, ok := r.cache[uri]
+	r.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	var data []byte
+	var err error
+	if strings.HasPrefix(uri, "data:") {
+		data, err = decodeDataURI(uri)
+	} else {
+		data, err = r.fetch(ctx, uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > r.maxSize() {
+		return nil, ErrMetadataTooLarge
+	}
+
+	var metadata NftMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("error decoding metadata from %s: %w", uri, err)
+	}
+
+	r.mu.Lock()
+	if r.cache == nil {
+		r.cache = map[string]*NftMetadata{}
+	}
+	r.cache[uri] = &metadata
+	r.mu.Unlock()
+	return &metadata, nil
+}
+
+// GatewayURLs maps a token or image URI to the HTTP URLs it can be fetched
+// from, one per configured gateway for IPFS.
+func (r *MetadataResolver) GatewayURLs(uri string) ([]string, error) {
+	uri = strings.TrimSpace(uri)
+
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/")
+		return r.ipfsURLs(path)
+	case strings.HasPrefix(uri, "ar://"):
+		return []string{strings.TrimRight(r.ArweaveGateway, "/") + "/" + strings.TrimPrefix(uri, "ar://")}, nil
+	case strings.HasPrefix(uri, "http://"), strings.HasPrefix(uri, "https://"):
+		return []string{uri}, nil
+	case strings.HasPrefix(uri, "Qm") && len(uri) >= 46, strings.HasPrefix(uri, "bafy"):
+		return r.ipfsURLs(uri)
+	default:
+		return nil, fmt.Errorf("unsupported token URI %q", uri)
+	}
+}
+
+func (r *MetadataResolver) ipfsURLs(path string) ([]string, error) {
+	if len(r.IPFSGateways) == 0 {
+		return nil, fmt.Errorf("no IPFS gateway configured")
+	}
+	urls := make([]string, len(r.IPFSGateways))
+	for i, gateway := range r.IPFSGateways {
+		urls[i] = strings.TrimRight(gateway, "/") + "/" + path
+	}
+	return urls, nil
+}
+
+func (r *MetadataResolver) fetch(ctx context.Context, uri string) ([]byte, error) {
+	urls, err := r.GatewayURLs(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, u := range urls {
+		data, err := r.fetchURL(ctx, u)
+		if err == nil {
+			return data, nil
+		}
+		if errors.Is(err, ErrMetadataTooLarge) || ctx.Err() != nil {
+			return nil, err
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func (r *MetadataResolver) fetchURL(ctx context.Context, u string) ([]byte, error) {
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %s: %w", u, err)
+	}
+	req.Header.Add("Accept", "application/json")
+
+	client := r.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching metadata from %s: %w", u, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching metadata from %s: %w", u, &HTTPError{StatusCode: response.StatusCode})
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, r.maxSize()+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading metadata from %s: %w", u, err)
+	}
+	if int64(len(data)) > r.maxSize() {
+		return nil, ErrMetadataTooLarge
+	}
+	return data, nil
+}
+
+func (r *MetadataResolver) maxSize() int64 {
+	if r.MaxSize <= 0 {
+		return 1 << 20
+	}
+	return r.MaxSize
+}
+
+func decodeDataURI(uri string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid data URI")
+	}
+
+	params := strings.Split(header, ";")
+	if mediaType := strings.ToLower(params[0]); mediaType != "" && mediaType != "application/json" && mediaType != "text/plain" {
+		return nil, fmt.Errorf("unsupported data URI media type %q", params[0])
+	}
+	for _, p := range params[1:] {
+		if strings.EqualFold(p, "base64") {
+			data, err := base64.StdEncoding.DecodeString(payload)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding base64 data URI: %w", err)
+			}
+			return data, nil
+		}
+	}
+
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding data URI: %w", err)
+	}
+	return []byte(data), nil
+}
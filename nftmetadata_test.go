@@ -0,0 +1,77 @@
+package kaiascan
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetadataResolver_Resolve(t *testing.T) {
+	requests := 0
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/ipfs/QmCollection/1":
+			w.Write([]byte(`{"name":"Item #1","image":"ipfs://QmImage","attributes":[{"trait_type":"Color","value":"red"}]}`))
+		case "/ar/tx123":
+			w.Write([]byte(`{"name":"Arweave Item"}`))
+		case "/ipfs/QmCollection/" + strings.Repeat("0", 62) + "0a":
+			w.Write([]byte(`{"name":"Edition 10"}`))
+		case "/ipfs/QmHuge":
+			w.Write([]byte(`{"name":"` + strings.Repeat("x", 2048) + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer gateway.Close()
+
+	resolver := NewMetadataResolver()
+	resolver.IPFSGateways = []string{gateway.URL + "/broken/", gateway.URL + "/ipfs/"}
+	resolver.ArweaveGateway = gateway.URL + "/ar"
+	resolver.MaxSize = 1024
+	ctx := context.Background()
+
+	metadata, err := resolver.Resolve(ctx, "ipfs://QmCollection/1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if metadata.Name != "Item #1" || metadata.Attributes[0].Value != "red" {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+
+	before := requests
+	if _, err := resolver.Resolve(ctx, "ipfs://QmCollection/1"); err != nil || requests != before {
+		t.Errorf("Expected cached metadata without new requests, got %d requests, %v", requests-before, err)
+	}
+
+	metadata, err = resolver.Resolve(ctx, "ar://tx123")
+	if err != nil || metadata.Name != "Arweave Item" {
+		t.Errorf("Unexpected arweave metadata: %+v, %v", metadata, err)
+	}
+
+	inline := "data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(`{"name":"On-chain"}`))
+	metadata, err = resolver.Resolve(ctx, inline)
+	if err != nil || metadata.Name != "On-chain" {
+		t.Errorf("Unexpected data URI metadata: %+v, %v", metadata, err)
+	}
+
+	metadata, err = resolver.ResolveItem(ctx, &NftItem{TokenId: "10", TokenUri: "ipfs://QmCollection/{id}"})
+	if err != nil || metadata.Name != "Edition 10" {
+		t.Errorf("Unexpected KIP-37 metadata: %+v, %v", metadata, err)
+	}
+	metadata, err = resolver.ResolveItem(ctx, &NftItem{TokenId: "010", TokenUri: "ipfs://QmCollection/{id}"})
+	if err != nil || metadata.Name != "Edition 10" {
+		t.Errorf("Expected a zero-padded token ID to be read as decimal, got %+v, %v", metadata, err)
+	}
+
+	if _, err := resolver.Resolve(ctx, "ipfs://QmHuge"); !errors.Is(err, ErrMetadataTooLarge) {
+		t.Errorf("Expected ErrMetadataTooLarge, got %v", err)
+	}
+	if _, err := resolver.Resolve(ctx, "ftp://example.com/1.json"); err == nil {
+		t.Error("Expected an error for an unsupported scheme")
+	}
+}
@@ -0,0 +1,224 @@
+package kaiascan
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	"kaiascan.go/units"
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+type NftHolding struct {
+	Owner    string       `json:"owner"`
+	TokenId  string       `json:"tokenId"`
+	Quantity units.Amount `json:"quantity"`
+}
+
+type NftSnapshot struct {
+	ContractAddress string       `json:"contractAddress"`
+	Kind            NftKind      `json:"kind"`
+	BlockNumber     *int         `json:"blockNumber,omitempty"`
+	Holdings        []NftHolding `json:"holdings"`
+}
+
+// SnapshotNftCollection lists every owner of every token in a collection.
+// With a nil blockNumberEnd the current holders are read from the inventory
+// and holder endpoints; otherwise all transfers up to and including that block
+// are replayed. Holdings are sorted by owner, then numerically by token ID.
+func SnapshotNftCollection(ctx context.Context, tokenAddress string, blockNumberEnd *int) (*NftSnapshot, error) {
+	return snapshotNftCollection(ctx, NewClient(), tokenAddress, blockNumberEnd)
+}
+
+func snapshotNftCollection(ctx context.Context, api NftsAPI, tokenAddress string, blockNumberEnd *int) (*NftSnapshot, error) {
+	if tokenAddress == "" {
+		return nil, fmt.Errorf("token address is required")
+	}
+
+	info, err := api.GetNftInfo(tokenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching collection info: %w", err)
+	}
+
+	holdings := nftHoldingSet{}
+	if blockNumberEnd == nil {
+		err = holdings.loadCurrent(ctx, api, tokenAddress, info.Data.Kind)
+	} else {
+		err = holdings.replayTransfers(ctx, api, tokenAddress, info.Data.Kind, *blockNumberEnd)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &NftSnapshot{
+		ContractAddress: tokenAddress,
+		Kind:            info.Data.Kind,
+		BlockNumber:     blockNumberEnd,
+		Holdings:        holdings.sorted(),
+	}, nil
+}
+
+// Owners groups the snapshot by owner address.
+func (s *NftSnapshot) Owners() map[string][]NftHolding {
+	owners := map[string][]NftHolding{}
+	for _, h := range s.Holdings {
+		owners[h.Owner] = append(owners[h.Owner], h)
+	}
+	return owners
+}
+
+func (s *NftSnapshot) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"owner", "token_id", "quantity"}); err != nil {
+		return err
+	}
+	for _, h := range s.Holdings {
+		if err := cw.Write([]string{h.Owner, h.TokenId, h.Quantity.String()}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (s *NftSnapshot) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// nftHoldingSet maps owner to token ID to quantity.
+type nftHoldingSet map[string]map[string]*big.Int
+
+func (h nftHoldingSet) add(owner string, tokenId string, quantity *big.Int) {
+	owner = strings.ToLower(owner)
+	if owner == "" || owner == zeroAddress {
+		return
+	}
+	tokens := h[owner]
+	if tokens == nil {
+		tokens = map[string]*big.Int{}
+		h[owner] = tokens
+	}
+	current := tokens[tokenId]
+	if current == nil {
+		current = new(big.Int)
+	}
+	current = new(big.Int).Add(current, quantity)
+	if current.Sign() <= 0 {
+		delete(tokens, tokenId)
+		if len(tokens) == 0 {
+			delete(h, owner)
+		}
+		return
+	}
+	tokens[tokenId] = current
+}
+
+// loadCurrent fills h from the inventory. Inventory rows carry one holder
+// each; KIP-37 rows without a holder fall back to the per-token holder list,
+// fetched once per token ID.
+func (h nftHoldingSet) loadCurrent(ctx context.Context, api NftsAPI, tokenAddress string, kind NftKind) error {
+	fetched := map[string]bool{}
+	return forEachPage(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftInventoryEntry]], error) {
+		return api.GetNftInventories(tokenAddress, page, size, nil)
+	}, func(entries []NftInventoryEntry) error {
+		for _, entry := range entries {
+			if kind != NftKindKIP37 {
+				h.add(entry.HolderAddress, entry.TokenId, big.NewInt(1))
+				continue
+			}
+			if entry.HolderAddress != "" {
+				h.add(entry.HolderAddress, entry.TokenId, entry.TokenCount.Big())
+				continue
+			}
+
+			tokenId := entry.TokenId
+			if fetched[tokenId] {
+				continue
+			}
+			fetched[tokenId] = true
+			err := forEachPage(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftHolder]], error) {
+				return api.GetNftHolders(tokenAddress, page, size, &tokenId)
+			}, func(holders []NftHolder) error {
+				for _, holder := range holders {
+					h.add(holder.HolderAddress, tokenId, holder.TokenCount.Big())
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("error fetching holders of token %s: %w", tokenId, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (h nftHoldingSet) replayTransfers(ctx context.Context, api NftsAPI, tokenAddress string, kind NftKind, blockNumberEnd int) error {
+	transfers, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftTransfer]], error) {
+		return api.GetNftTransfers(tokenAddress, page, size, nil, nil, &blockNumberEnd)
+	})
+	if err != nil {
+		return fmt.Errorf("error fetching transfers: %w", err)
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		if transfers[i].BlockNumber != transfers[j].BlockNumber {
+			return transfers[i].BlockNumber < transfers[j].BlockNumber
+		}
+		return transfers[i].LogIndex < transfers[j].LogIndex
+	})
+
+	for _, t := range transfers {
+		if t.BlockNumber > int64(blockNumberEnd) {
+			continue
+		}
+		quantity := t.Quantity.Big()
+		if (kind != NftKindKIP37 && t.Kind != NftKindKIP37) || quantity.Sign() == 0 {
+			quantity = big.NewInt(1)
+		}
+		h.add(t.From, t.TokenId, new(big.Int).Neg(quantity))
+		h.add(t.To, t.TokenId, quantity)
+	}
+	return nil
+}
+
+func (h nftHoldingSet) sorted() []NftHolding {
+	holdings := []NftHolding{}
+	for owner, tokens := range h {
+		for tokenId, quantity := range tokens {
+			holdings = append(holdings, NftHolding{Owner: owner, TokenId: tokenId, Quantity: units.NewAmount(quantity)})
+		}
+	}
+	sort.Slice(holdings, func(i, j int) bool {
+		if holdings[i].Owner != holdings[j].Owner {
+			return holdings[i].Owner < holdings[j].Owner
+		}
+		return lessTokenId(holdings[i].TokenId, holdings[j].TokenId)
+	})
+	return holdings
+}
+
+// lessTokenId orders decimal token IDs numerically and before any IDs that
+// are not decimal, which are ordered as strings.
+func lessTokenId(a string, b string) bool {
+	x, okA := new(big.Int).SetString(a, 10)
+	y, okB := new(big.Int).SetString(b, 10)
+	switch {
+	case okA && okB:
+		if c := x.Cmp(y); c != 0 {
+			return c < 0
+		}
+		return a < b
+	case okA != okB:
+		return okA
+	default:
+		return a < b
+	}
+}
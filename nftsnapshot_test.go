@@ -0,0 +1,152 @@
+package kaiascan
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"kaiascan.go/units"
+)
+
+func TestSnapshotNftCollection_Replay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/transfers"):
+			if r.URL.Query().Get("blockNumberEnd") != "100" {
+				t.Fatalf("Expected blockNumberEnd=100, got %s", r.URL.RawQuery)
+			}
+			transfers := []NftTransfer{
+				{BlockNumber: 30, From: "0xAlice", To: "0xCarol", TokenId: "2"},
+				{BlockNumber: 10, From: zeroAddress, To: "0xAlice", TokenId: "10"},
+				{BlockNumber: 10, LogIndex: 1, From: zeroAddress, To: "0xAlice", TokenId: "2"},
+				{BlockNumber: 20, From: zeroAddress, To: "0xBob", TokenId: "3"},
+				{BlockNumber: 40, From: "0xBob", To: zeroAddress, TokenId: "3"},
+			}
+			w.Write(mockApiResponse(Page[NftTransfer]{Paging: Paging{Last: true}, Results: transfers}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/nfts/0xnft"):
+			w.Write(mockApiResponse(NftCollection{ContractAddress: "0xnft", Kind: NftKindKIP17}, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	block := 100
+	snapshot, err := SnapshotNftCollection(context.Background(), "0xnft", &block)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var csvOut bytes.Buffer
+	if err := snapshot.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := "owner,token_id,quantity\n0xalice,10,1\n0xcarol,2,1\n"
+	if csvOut.String() != want {
+		t.Errorf("Unexpected CSV:\n%s\nwant:\n%s", csvOut.String(), want)
+	}
+
+	var jsonOut bytes.Buffer
+	if err := snapshot.WriteJSON(&jsonOut); err != nil || !strings.Contains(jsonOut.String(), `"blockNumber": 100`) {
+		t.Errorf("Unexpected JSON: %s, %v", jsonOut.String(), err)
+	}
+}
+
+func TestSnapshotNftCollection_CurrentKIP37(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/inventories"):
+			entries := []NftInventoryEntry{{TokenId: "1"}, {TokenId: "2"}}
+			w.Write(mockApiResponse(Page[NftInventoryEntry]{Paging: Paging{Last: true}, Results: entries}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/holders"):
+			var holders []NftHolder
+			switch r.URL.Query().Get("tokenId") {
+			case "1":
+				holders = []NftHolder{{HolderAddress: "0xbob", TokenCount: amountOf(5)}, {HolderAddress: "0xalice", TokenCount: amountOf(2)}}
+			case "2":
+				holders = []NftHolder{{HolderAddress: "0xalice", TokenCount: amountOf(1)}}
+			}
+			w.Write(mockApiResponse(Page[NftHolder]{Paging: Paging{Last: true}, Results: holders}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/nfts/0xmulti"):
+			w.Write(mockApiResponse(NftCollection{ContractAddress: "0xmulti", Kind: NftKindKIP37}, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	snapshot, err := SnapshotNftCollection(context.Background(), "0xmulti", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	owners := snapshot.Owners()
+	if len(owners["0xalice"]) != 2 || owners["0xbob"][0].Quantity.String() != "5" {
+		t.Errorf("Unexpected owners: %+v", owners)
+	}
+}
+
+func TestSnapshotNftCollection_MultiHolderKIP37(t *testing.T) {
+	holderRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/inventories"):
+			entries := []NftInventoryEntry{
+				{TokenId: "1", HolderAddress: "0xbob", TokenCount: amountOf(5)},
+				{TokenId: "1", HolderAddress: "0xalice", TokenCount: amountOf(2)},
+				{TokenId: "2"},
+				{TokenId: "2"},
+			}
+			w.Write(mockApiResponse(Page[NftInventoryEntry]{Paging: Paging{Last: true}, Results: entries}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/holders"):
+			holderRequests++
+			if r.URL.Query().Get("tokenId") != "2" {
+				t.Fatalf("Unexpected holder request: %s", r.URL.RawQuery)
+			}
+			holders := []NftHolder{{HolderAddress: "0xalice", TokenCount: amountOf(1)}, {HolderAddress: "0xcarol", TokenCount: amountOf(3)}}
+			w.Write(mockApiResponse(Page[NftHolder]{Paging: Paging{Last: true}, Results: holders}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/nfts/0xmulti"):
+			w.Write(mockApiResponse(NftCollection{ContractAddress: "0xmulti", Kind: NftKindKIP37}, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	snapshot, err := SnapshotNftCollection(context.Background(), "0xmulti", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var csvOut bytes.Buffer
+	if err := snapshot.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := "owner,token_id,quantity\n0xalice,1,2\n0xalice,2,1\n0xbob,1,5\n0xcarol,2,3\n"
+	if csvOut.String() != want {
+		t.Errorf("Unexpected CSV:\n%s\nwant:\n%s", csvOut.String(), want)
+	}
+	if holderRequests != 1 {
+		t.Errorf("Expected holders of token 2 to be fetched once, got %d requests", holderRequests)
+	}
+}
+
+func amountOf(v int64) units.Amount {
+	return units.NewAmount(big.NewInt(v))
+}
+
+func TestLessTokenId(t *testing.T) {
+	ids := []string{"abc", "010", "0x1f", "9", "10", "2"}
+	sort.Slice(ids, func(i, j int) bool { return lessTokenId(ids[i], ids[j]) })
+	if got := strings.Join(ids, " "); got != "2 9 010 10 0x1f abc" {
+		t.Errorf("Unexpected token ID order: %s", got)
+	}
+}
@@ -0,0 +1,411 @@
+// Package output renders typed SDK results as pretty JSON, JSON Lines, CSV or
+// an aligned terminal table.
+package output
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+)
+
+type Format string
+
+const (
+	JSON  Format = "json"
+	JSONL Format = "jsonl"
+	CSV   Format = "csv"
+	Table Format = "table"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case JSON, JSONL, CSV, Table:
+		return f, nil
+	case "ndjson":
+		return JSONL, nil
+	default:
+		return "", fmt.Errorf("unknown output format %q, expected json, jsonl, csv or table", s)
+	}
+}
+
+type Options struct {
+	// Columns selects and orders the CSV and table columns by their JSON
+	// name. All columns are written when empty.
+	Columns []string
+	// OmitHeader leaves out the CSV header row, for appending to an
+	// existing file.
+	OmitHeader bool
+	// MaxWidth truncates table cells to this many characters. Zero means no
+	// limit.
+	MaxWidth int
+}
+
+// Writer renders rows one at a time. JSON Lines and CSV rows are written as
+// they arrive; JSON and tables are buffered until Close.
+type Writer interface {
+	Write(row any) error
+	Close() error
+}
+
+func NewWriter(w io.Writer, f Format, opts Options) (Writer, error) {
+	switch f {
+	case JSON:
+		return &jsonWriter{w: w, rows: []any{}}, nil
+	case JSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w)}, nil
+	case CSV:
+		return &csvWriter{cw: csv.NewWriter(w), opts: opts}, nil
+	case Table:
+		return &tableWriter{w: w, opts: opts}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", f)
+	}
+}
+
+// Render writes v in the given format. A slice is written as one row per
+// element and a struct with a "results" slice, such as a kaiascan.Page, as
+// its results; anything else is a single row. Pretty JSON keeps v as is.
+func Render(w io.Writer, f Format, v any, opts Options) error {
+	if f == JSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	out, err := NewWriter(w, f, opts)
+	if err != nil {
+		return err
+	}
+	for _, row := range Rows(v) {
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	return out.Close()
+}
+
+// Rows splits v into the rows Render writes.
+func Rows(v any) []any {
+	rv := indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return nil
+	}
+	if rv.Kind() == reflect.Struct {
+		for i := 0; i < rv.NumField(); i++ {
+			name, ok := fieldName(rv.Type().Field(i))
+			if ok && name == "results" && rv.Field(i).Kind() == reflect.Slice {
+				rv = rv.Field(i)
+				break
+			}
+		}
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+
+	rows := make([]any, rv.Len())
+	for i := range rows {
+		rows[i] = rv.Index(i).Interface()
+	}
+	return rows
+}
+
+type jsonWriter struct {
+	w    io.Writer
+	rows []any
+}
+
+func (j *jsonWriter) Write(row any) error {
+	j.rows = append(j.rows, row)
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	enc := json.NewEncoder(j.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(j.rows)
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonlWriter) Write(row any) error {
+	return j.enc.Encode(row)
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+type csvWriter struct {
+	cw      *csv.Writer
+	opts    Options
+	columns []string
+}
+
+func (c *csvWriter) Write(row any) error {
+	if c.columns == nil {
+		columns, err := selectColumns(Columns(row), c.opts.Columns)
+		if err != nil {
+			return err
+		}
+		c.columns = columns
+		if !c.opts.OmitHeader {
+			if err := c.cw.Write(columns); err != nil {
+				return err
+			}
+		}
+	}
+	return c.cw.Write(Values(row, c.columns))
+}
+
+func (c *csvWriter) Close() error {
+	if c.columns == nil && len(c.opts.Columns) > 0 && !c.opts.OmitHeader {
+		if err := c.cw.Write(c.opts.Columns); err != nil {
+			return err
+		}
+	}
+	c.cw.Flush()
+	return c.cw.Error()
+}
+
+type tableWriter struct {
+	w    io.Writer
+	opts Options
+	rows []any
+}
+
+func (t *tableWriter) Write(row any) error {
+	t.rows = append(t.rows, row)
+	return nil
+}
+
+func (t *tableWriter) Close() error {
+	var columns []string
+	seen := map[string]bool{}
+	for _, row := range t.rows {
+		for _, c := range Columns(row) {
+			if !seen[c] {
+				seen[c] = true
+				columns = append(columns, c)
+			}
+		}
+	}
+	columns, err := selectColumns(columns, t.opts.Columns)
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(t.w, 0, 0, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = strings.ToUpper(t.cell(c))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range t.rows {
+		values := Values(row, columns)
+		for i := range values {
+			values[i] = t.cell(values[i])
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (t *tableWriter) cell(s string) string {
+	s = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(s)
+	if t.opts.MaxWidth > 0 && utf8.RuneCountInString(s) > t.opts.MaxWidth {
+		runes := []rune(s)
+		if t.opts.MaxWidth == 1 {
+			return "…"
+		}
+		return strings.TrimRight(string(runes[:t.opts.MaxWidth-1]), " ") + "…"
+	}
+	return s
+}
+
+func selectColumns(available []string, selected []string) ([]string, error) {
+	if len(selected) == 0 {
+		return available, nil
+	}
+	known := map[string]bool{}
+	for _, c := range available {
+		known[c] = true
+	}
+	for _, c := range selected {
+		if !known[c] && len(available) > 0 {
+			return nil, fmt.Errorf("unknown column %q, expected one of %s", c, strings.Join(available, ", "))
+		}
+	}
+	return selected, nil
+}
+
+// Columns lists the column names of a row in a stable order: struct fields in
+// declaration order by JSON name, with embedded structs flattened, or sorted
+// map keys. Any other value is a single "value" column.
+func Columns(row any) []string {
+	rv := indirect(reflect.ValueOf(row))
+	switch {
+	case !rv.IsValid():
+		return nil
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		columns := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			columns = append(columns, k.String())
+		}
+		sort.Strings(columns)
+		return columns
+	case rv.Kind() == reflect.Struct && !isScalar(rv):
+		var columns []string
+		structFields(rv.Type(), nil, func(name string, _ []int) {
+			columns = append(columns, name)
+		})
+		return columns
+	default:
+		return []string{"value"}
+	}
+}
+
+// Values returns the cells of a row for the given columns. Missing columns
+// are empty.
+func Values(row any, columns []string) []string {
+	rv := indirect(reflect.ValueOf(row))
+	values := make([]string, len(columns))
+	switch {
+	case !rv.IsValid():
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		for i, c := range columns {
+			values[i] = cell(rv.MapIndex(reflect.ValueOf(c).Convert(rv.Type().Key())))
+		}
+	case rv.Kind() == reflect.Struct && !isScalar(rv):
+		index := map[string][]int{}
+		structFields(rv.Type(), nil, func(name string, path []int) {
+			index[name] = path
+		})
+		for i, c := range columns {
+			if path, ok := index[c]; ok {
+				values[i] = cell(fieldByIndex(rv, path))
+			}
+		}
+	default:
+		for i, c := range columns {
+			if c == "value" {
+				values[i] = cell(rv)
+			}
+		}
+	}
+	return values
+}
+
+func structFields(t reflect.Type, prefix []int, visit func(name string, path []int)) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		path := append(append([]int{}, prefix...), i)
+		if f.Anonymous {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if _, tagged := f.Tag.Lookup("json"); !tagged && ft.Kind() == reflect.Struct {
+				structFields(ft, path, visit)
+				continue
+			}
+		}
+		if name, ok := fieldName(f); ok {
+			visit(name, path)
+		}
+	}
+}
+
+func fieldName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
+
+func fieldByIndex(v reflect.Value, path []int) reflect.Value {
+	for _, i := range path {
+		v = indirect(v)
+		if !v.IsValid() {
+			return v
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+var (
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	stringerType      = reflect.TypeFor[fmt.Stringer]()
+)
+
+// isScalar reports whether a struct renders as a single cell, like time.Time
+// or units.Amount.
+func isScalar(v reflect.Value) bool {
+	return v.Type().Implements(textMarshalerType) || v.Type().Implements(stringerType)
+}
+
+func cell(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err == nil {
+			return string(text)
+		}
+	}
+	if v.Kind() != reflect.String && v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
@@ -0,0 +1,135 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"kaiascan.go/units"
+)
+
+type paging struct {
+	Last bool `json:"last"`
+}
+
+type transfer struct {
+	Hash     string       `json:"transactionHash"`
+	Block    int64        `json:"blockId"`
+	Datetime time.Time    `json:"datetime"`
+	Amount   units.Amount `json:"amount"`
+	Topics   []string     `json:"topics,omitempty"`
+	Note     *string      `json:"note"`
+	Ignored  string       `json:"-"`
+	internal string
+}
+
+type page struct {
+	Paging  paging     `json:"paging"`
+	Results []transfer `json:"results"`
+}
+
+func testRows() page {
+	note := "line one\nline two, \"quoted\""
+	return page{Results: []transfer{
+		{Hash: "0xaaa", Block: 10, Datetime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Amount: units.NewAmount(nil), Topics: []string{"0x1", "0x2"}},
+		{Hash: "0xbbb", Block: 11, Amount: amount("1500"), Note: &note},
+	}}
+}
+
+func amount(s string) units.Amount {
+	a, err := units.ParseUnits(s, 0)
+	if err != nil {
+		panic(err)
+	}
+	return units.NewAmount(a)
+}
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"json": JSON, "JSONL": JSONL, "ndjson": JSONL, "csv": CSV, "table": Table} {
+		if got, err := ParseFormat(in); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v", in, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestRender_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, CSV, testRows(), Options{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := "transactionHash,blockId,datetime,amount,topics,note\n" +
+		"0xaaa,10,2024-01-02T03:04:05Z,0,\"[\"\"0x1\"\",\"\"0x2\"\"]\",\n" +
+		"0xbbb,11,0001-01-01T00:00:00Z,1500,null,\"line one\nline two, \"\"quoted\"\"\"\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+}
+
+func TestRender_CSVColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, CSV, testRows(), Options{Columns: []string{"amount", "transactionHash"}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != "amount,transactionHash\n0,0xaaa\n1500,0xbbb\n" {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+
+	err := Render(&bytes.Buffer{}, CSV, testRows(), Options{Columns: []string{"fee"}})
+	if err == nil || !strings.Contains(err.Error(), `unknown column "fee"`) {
+		t.Errorf("Expected an unknown column error, got %v", err)
+	}
+}
+
+func TestRender_JSONL(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, JSONL, testRows(), Options{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], `{"transactionHash":"0xbbb","blockId":11,`) {
+		t.Errorf("Unexpected JSON Lines:\n%s", buf.String())
+	}
+}
+
+func TestRender_Table(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, Table, testRows(), Options{Columns: []string{"transactionHash", "note"}, MaxWidth: 10})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	want := "TRANSACTI…  NOTE\n" +
+		"0xaaa       \n" +
+		"0xbbb       line one…\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected table:\n%q", buf.String())
+	}
+}
+
+func TestRender_Maps(t *testing.T) {
+	rows := []map[string]any{{"b": 2.0, "a": "x"}, {"a": "y"}}
+	var buf bytes.Buffer
+	if err := Render(&buf, CSV, rows, Options{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != "a,b\nx,2\ny,\n" {
+		t.Errorf("Unexpected CSV:\n%s", buf.String())
+	}
+}
+
+func TestWriter_EmptyJSON(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, JSON, Options{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("Expected an empty array, got %q", buf.String())
+	}
+}
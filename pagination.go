@@ -0,0 +1,53 @@
+package kaiascan
+
+import "context"
+
+const maxPageSize = 2000
+
+type pageFetcher[T any] func(page int, size int) (*ApiResponse[Page[T]], error)
+
+// forEachPage walks a paginated endpoint from the first page, handing each
+// non-empty page of results to fn, until the endpoint reports its last page.
+func forEachPage[T any](ctx context.Context, size int, fetch pageFetcher[T], fn func([]T) error) error {
+	return forEachPageFrom(ctx, 1, size, fetch, func(_ int, results []T) error {
+		return fn(results)
+	})
+}
+
+// forEachPageFrom is forEachPage starting at page start, which also passes
+// the page number to fn.
+func forEachPageFrom[T any](ctx context.Context, start int, size int, fetch pageFetcher[T], fn func(page int, results []T) error) error {
+	for page := start; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resp, err := fetch(page, size)
+		if err != nil {
+			return err
+		}
+		results := resp.Data.Results
+		if len(results) > 0 {
+			if err := fn(page, results); err != nil {
+				return err
+			}
+		}
+
+		paging := resp.Data.Paging
+		if paging.Last || len(results) < size || (paging.TotalPage > 0 && page >= paging.TotalPage) {
+			return nil
+		}
+	}
+}
+
+func collectPages[T any](ctx context.Context, size int, fetch pageFetcher[T]) ([]T, error) {
+	var all []T
+	err := forEachPage(ctx, size, fetch, func(results []T) error {
+		all = append(all, results...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,171 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"kaiascan.go/units"
+)
+
+type PortfolioError struct {
+	Source string
+	Err    error
+}
+
+func (e *PortfolioError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Source, e.Err)
+}
+
+func (e *PortfolioError) Unwrap() error {
+	return e.Err
+}
+
+type Portfolio struct {
+	Address string
+	Account *AccountInfo
+	Native  units.Amount
+	Tokens  []TokenAmount
+	Nfts    []NftBalance
+	Errors  []*PortfolioError
+}
+
+// Partial reports whether some of the underlying requests failed, in which
+// case the corresponding part of the portfolio is missing.
+func (p *Portfolio) Partial() bool {
+	return len(p.Errors) > 0
+}
+
+// GetPortfolio fetches the native balance, fungible token balances and NFT
+// holdings of an account concurrently and merges them. Failures of individual
+// sources are collected in Portfolio.Errors; an error is returned only when
+// every source failed or ctx was cancelled.
+func GetPortfolio(ctx context.Context, accountAddress string) (*Portfolio, error) {
+	return getPortfolio(ctx, NewClient(), accountAddress)
+}
+
+func getPortfolio(ctx context.Context, api interface {
+	AccountsAPI
+	TokensAPI
+}, accountAddress string) (*Portfolio, error) {
+	if accountAddress == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+
+	var (
+		wg                sync.WaitGroup
+		account           *AccountInfo
+		balances, details []TokenBalance
+		kip17, kip37      []NftBalance
+		errs              [5]error
+	)
+
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		resp, err := api.GetAccountInfo(accountAddress)
+		if err != nil {
+			errs[0] = err
+			return
+		}
+		account = &resp.Data
+	}()
+	go func() {
+		defer wg.Done()
+		balances, errs[1] = collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
+			return api.GetAccountTokenBalances(accountAddress, page, size)
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		details, errs[2] = collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenBalance]], error) {
+			return api.GetAccountTokenDetails(accountAddress, page, size)
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		kip17, errs[3] = collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftBalance]], error) {
+			return api.GetAccountKIP17NftBalances(accountAddress, page, size)
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		kip37, errs[4] = collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[NftBalance]], error) {
+			return api.GetAccountKIP37NftBalances(accountAddress, page, size)
+		})
+	}()
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	portfolio := &Portfolio{Address: accountAddress}
+	failed := 0
+	for i, source := range []string{"account", "token-balances", "token-details", "kip17-balances", "kip37-balances"} {
+		if errs[i] != nil {
+			failed++
+			portfolio.Errors = append(portfolio.Errors, &PortfolioError{Source: source, Err: errs[i]})
+		}
+	}
+	if failed == len(errs) {
+		return nil, errors.Join(errs[:]...)
+	}
+
+	if account != nil {
+		portfolio.Account = account
+		portfolio.Native = account.Balance
+	}
+
+	cache := NewTokenMetadataCache()
+	cache.API = api
+	for _, balance := range mergeTokenBalances(balances, details) {
+		amount, err := cache.FormatAmount(balance.ContractAddress, balance.Balance)
+		if err != nil {
+			portfolio.Errors = append(portfolio.Errors, &PortfolioError{Source: "token-metadata " + balance.ContractAddress, Err: err})
+			amount = &TokenAmount{TokenAddress: balance.ContractAddress, Raw: balance.Balance}
+		}
+		portfolio.Tokens = append(portfolio.Tokens, *amount)
+	}
+
+	for _, nft := range kip17 {
+		if nft.Kind == "" {
+			nft.Kind = NftKindKIP17
+		}
+		portfolio.Nfts = append(portfolio.Nfts, nft)
+	}
+	for _, nft := range kip37 {
+		if nft.Kind == "" {
+			nft.Kind = NftKindKIP37
+		}
+		portfolio.Nfts = append(portfolio.Nfts, nft)
+	}
+
+	return portfolio, nil
+}
+
+// mergeTokenBalances combines the token balance and token detail lists,
+// keeping one non-zero entry per contract sorted by address.
+func mergeTokenBalances(lists ...[]TokenBalance) []TokenBalance {
+	merged := map[string]TokenBalance{}
+	for _, list := range lists {
+		for _, balance := range list {
+			if balance.Balance.IsZero() {
+				continue
+			}
+			merged[strings.ToLower(balance.ContractAddress)] = balance
+		}
+	}
+
+	result := make([]TokenBalance, 0, len(merged))
+	for _, balance := range merged {
+		result = append(result, balance)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i].ContractAddress) < strings.ToLower(result[j].ContractAddress)
+	})
+	return result
+}
@@ -0,0 +1,68 @@
+package kaiascan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetPortfolio(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.Path
+		switch {
+		case strings.HasSuffix(p, "/accounts/0xwallet"):
+			w.Write(mockApiResponse(AccountInfo{Address: "0xwallet", Balance: amountOf(2500000000000000000)}, 0, "Success"))
+		case strings.HasSuffix(p, "/token-balances"):
+			balances := []TokenBalance{{ContractAddress: "0xusdt", Balance: amountOf(1500000)}, {ContractAddress: "0xdust", Balance: amountOf(0)}}
+			w.Write(mockApiResponse(Page[TokenBalance]{Paging: Paging{Last: true}, Results: balances}, 0, "Success"))
+		case strings.HasSuffix(p, "/token-details"):
+			details := []TokenBalance{{ContractAddress: "0xUSDT", Balance: amountOf(1500000)}, {ContractAddress: "0xmystery", Balance: amountOf(9)}}
+			w.Write(mockApiResponse(Page[TokenBalance]{Paging: Paging{Last: true}, Results: details}, 0, "Success"))
+		case strings.HasSuffix(p, "/nft-balances/kip17"):
+			nfts := []NftBalance{{ContractAddress: "0xpunks", TokenCount: amountOf(2)}}
+			w.Write(mockApiResponse(Page[NftBalance]{Paging: Paging{Last: true}, Results: nfts}, 0, "Success"))
+		case strings.HasSuffix(p, "/nft-balances/kip37"):
+			w.WriteHeader(http.StatusInternalServerError)
+		case p == "/api/v1/tokens":
+			if r.URL.Query().Get("tokenAddress") == "0xmystery" {
+				w.Write(mockApiResponse(TokenInfo{}, 404, "token not found"))
+				return
+			}
+			w.Write(mockApiResponse(TokenInfo{Symbol: "USDT", Decimal: 6}, 0, "Success"))
+		default:
+			t.Errorf("Unexpected API path: %s", p)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	portfolio, err := GetPortfolio(context.Background(), "0xwallet")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if portfolio.Native.String() != "2500000000000000000" {
+		t.Errorf("Unexpected native balance: %s", portfolio.Native)
+	}
+	if len(portfolio.Tokens) != 2 {
+		t.Fatalf("Expected 2 tokens, got %+v", portfolio.Tokens)
+	}
+	if portfolio.Tokens[1].String() != "1.5 USDT" || portfolio.Tokens[0].Known {
+		t.Errorf("Unexpected tokens: %+v", portfolio.Tokens)
+	}
+	if len(portfolio.Nfts) != 1 || portfolio.Nfts[0].Kind != NftKindKIP17 {
+		t.Errorf("Unexpected NFTs: %+v", portfolio.Nfts)
+	}
+
+	if !portfolio.Partial() || len(portfolio.Errors) != 1 || portfolio.Errors[0].Source != "kip37-balances" {
+		t.Fatalf("Expected a partial failure for KIP-37 balances, got %+v", portfolio.Errors)
+	}
+	var httpErr *HTTPError
+	if !errors.As(portfolio.Errors[0], &httpErr) || httpErr.StatusCode != 500 {
+		t.Errorf("Expected the underlying HTTP error, got %v", portfolio.Errors[0])
+	}
+}
@@ -0,0 +1,282 @@
+package kaiascan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type ProxyKind string
+
+const (
+	ProxyKindNone        ProxyKind = ""
+	ProxyKindUnknown     ProxyKind = "unknown"
+	ProxyKindEIP1967     ProxyKind = "eip1967"
+	ProxyKindTransparent ProxyKind = "transparent"
+	ProxyKindBeacon      ProxyKind = "beacon"
+	ProxyKindEIP1822     ProxyKind = "eip1822"
+	ProxyKindZeppelinOS  ProxyKind = "zeppelinos"
+)
+
+const (
+	eip1967ImplementationSlot = "360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"
+	eip1967AdminSlot          = "b53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"
+	eip1967BeaconSlot         = "a3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50"
+	eip1822ProxiableSlot      = "c5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7"
+	zeppelinOSImplementation  = "7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3"
+
+	upgradedTopic       = "0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b"
+	adminChangedTopic   = "0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f"
+	beaconUpgradedTopic = "0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e"
+)
+
+type ProxyUpgradeKind string
+
+const (
+	ProxyUpgradeImplementation ProxyUpgradeKind = "implementation"
+	ProxyUpgradeAdmin          ProxyUpgradeKind = "admin"
+	ProxyUpgradeBeacon         ProxyUpgradeKind = "beacon"
+)
+
+type ProxyUpgrade struct {
+	Kind            ProxyUpgradeKind
+	Address         string
+	BlockNumber     int64
+	LogIndex        int
+	TransactionHash string
+}
+
+type ProxyResolution struct {
+	Address        string
+	Kind           ProxyKind
+	Implementation string
+	Admin          string
+	Beacon         string
+	History        []ProxyUpgrade
+}
+
+func (r *ProxyResolution) IsProxy() bool {
+	return r.Kind != ProxyKindNone
+}
+
+// ResolveImplementation works out whether contractAddress is a proxy and, if
+// so, which implementation it currently delegates to. Kaiascan does not expose
+// storage reads, so the implementation is taken from contract info and the
+// Upgraded, AdminChanged and BeaconUpgraded event logs. When either marks the
+// contract as a proxy, its kind is inferred from the well-known storage slot
+// constants baked into the bytecode. For beacon proxies the beacon's own
+// Upgraded events are followed.
+func ResolveImplementation(ctx context.Context, contractAddress string) (*ProxyResolution, error) {
+	return resolveImplementation(ctx, NewClient(), contractAddress)
+}
+
+// proxyAPI is the part of the API that proxy resolution reads.
+type proxyAPI interface {
+	AccountsAPI
+	ContractsAPI
+}
+
+func resolveImplementation(ctx context.Context, api proxyAPI, contractAddress string) (*ProxyResolution, error) {
+	if contractAddress == "" {
+		return nil, fmt.Errorf("contract address is required")
+	}
+
+	resolution := &ProxyResolution{Address: contractAddress}
+
+	info, err := api.GetContractInfo(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching contract info: %w", err)
+	}
+	if info.Data.Proxy || info.Data.ImplementationAddress != "" {
+		resolution.Kind = ProxyKindUnknown
+		resolution.Implementation = info.Data.ImplementationAddress
+	}
+
+	history, err := proxyUpgradeHistory(ctx, api, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	resolution.History = history
+
+	upgraded := false
+	for _, upgrade := range history {
+		switch upgrade.Kind {
+		case ProxyUpgradeImplementation:
+			resolution.Implementation = upgrade.Address
+			upgraded = true
+		case ProxyUpgradeAdmin:
+			resolution.Admin = upgrade.Address
+		case ProxyUpgradeBeacon:
+			resolution.Beacon = upgrade.Address
+			upgraded = true
+		}
+	}
+
+	// UUPS implementations embed the same slot constants as the proxies in
+	// front of them, so the bytecode only refines the kind of a contract
+	// already known to be a proxy.
+	if resolution.Kind != ProxyKindNone || upgraded {
+		code, err := api.GetContractCreationCode(contractAddress)
+		switch {
+		case err == nil:
+			if kind := proxyKindFromBytecode(code.Data.CreationCode); kind != ProxyKindNone {
+				resolution.Kind = kind
+			}
+		case !isNotFound(err):
+			return nil, fmt.Errorf("error fetching creation code: %w", err)
+		}
+	}
+	if len(history) > 0 && resolution.Kind == ProxyKindNone {
+		resolution.Kind = ProxyKindEIP1967
+	}
+	if resolution.Beacon != "" {
+		resolution.Kind = ProxyKindBeacon
+		beaconHistory, err := proxyUpgradeHistory(ctx, api, resolution.Beacon)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving beacon %s: %w", resolution.Beacon, err)
+		}
+		for _, upgrade := range beaconHistory {
+			if upgrade.Kind == ProxyUpgradeImplementation {
+				resolution.Implementation = upgrade.Address
+			}
+		}
+	}
+
+	return resolution, nil
+}
+
+// GetMergedAbi returns the ABI of contractAddress merged with the ABI of its
+// current implementation when it is a proxy. Implementation entries win over
+// proxy entries with the same signature.
+func GetMergedAbi(ctx context.Context, contractAddress string) (Abi, error) {
+	return getMergedAbi(ctx, NewClient(), contractAddress)
+}
+
+func getMergedAbi(ctx context.Context, api proxyAPI, contractAddress string) (Abi, error) {
+	resolution, err := resolveImplementation(ctx, api, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyAbi, err := api.GetContractAbi(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching proxy ABI: %w", err)
+	}
+	if resolution.Implementation == "" || strings.EqualFold(resolution.Implementation, contractAddress) {
+		return proxyAbi.Data.Abi, nil
+	}
+
+	implementationAbi, err := api.GetContractAbi(resolution.Implementation)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching implementation ABI for %s: %w", resolution.Implementation, err)
+	}
+	return mergeAbis(implementationAbi.Data.Abi, proxyAbi.Data.Abi), nil
+}
+
+func mergeAbis(implementation Abi, proxy Abi) Abi {
+	merged := make(Abi, 0, len(implementation)+len(proxy))
+	seen := map[string]bool{}
+
+	for _, entry := range implementation {
+		if entry.Type == "constructor" {
+			continue
+		}
+		seen[entry.Type+" "+entry.Signature()] = true
+		merged = append(merged, entry)
+	}
+	for _, entry := range proxy {
+		if key := entry.Type + " " + entry.Signature(); !seen[key] {
+			seen[key] = true
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+func proxyKindFromBytecode(code string) ProxyKind {
+	code = strings.ToLower(code)
+	switch {
+	case strings.Contains(code, eip1967BeaconSlot):
+		return ProxyKindBeacon
+	case strings.Contains(code, eip1967AdminSlot) && strings.Contains(code, eip1967ImplementationSlot):
+		return ProxyKindTransparent
+	case strings.Contains(code, eip1967ImplementationSlot):
+		return ProxyKindEIP1967
+	case strings.Contains(code, eip1822ProxiableSlot):
+		return ProxyKindEIP1822
+	case strings.Contains(code, zeppelinOSImplementation):
+		return ProxyKindZeppelinOS
+	default:
+		return ProxyKindNone
+	}
+}
+
+func proxyUpgradeHistory(ctx context.Context, api AccountsAPI, contractAddress string) ([]ProxyUpgrade, error) {
+	var history []ProxyUpgrade
+
+	for _, topic := range []string{upgradedTopic, adminChangedTopic, beaconUpgradedTopic} {
+		signature := topic
+		logs, err := collectPages(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[EventLog]], error) {
+			return api.GetAccountEventLogs(contractAddress, page, size, &signature, nil, nil)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching event logs for %s: %w", contractAddress, err)
+		}
+
+		for _, log := range logs {
+			if upgrade, ok := proxyUpgradeFromLog(log); ok {
+				history = append(history, upgrade)
+			}
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		if history[i].BlockNumber != history[j].BlockNumber {
+			return history[i].BlockNumber < history[j].BlockNumber
+		}
+		return history[i].LogIndex < history[j].LogIndex
+	})
+	return history, nil
+}
+
+func proxyUpgradeFromLog(log EventLog) (ProxyUpgrade, bool) {
+	if len(log.Topics) == 0 {
+		return ProxyUpgrade{}, false
+	}
+	upgrade := ProxyUpgrade{BlockNumber: log.BlockNumber, LogIndex: log.LogIndex, TransactionHash: log.TransactionHash}
+
+	switch strings.ToLower(log.Topics[0]) {
+	case upgradedTopic:
+		upgrade.Kind = ProxyUpgradeImplementation
+		if len(log.Topics) < 2 {
+			return ProxyUpgrade{}, false
+		}
+		upgrade.Address = addressFromWord(log.Topics[1])
+	case beaconUpgradedTopic:
+		upgrade.Kind = ProxyUpgradeBeacon
+		if len(log.Topics) < 2 {
+			return ProxyUpgrade{}, false
+		}
+		upgrade.Address = addressFromWord(log.Topics[1])
+	case adminChangedTopic:
+		upgrade.Kind = ProxyUpgradeAdmin
+		data := strings.TrimPrefix(strings.ToLower(log.Data), "0x")
+		if len(data) < 128 {
+			return ProxyUpgrade{}, false
+		}
+		upgrade.Address = addressFromWord(data[64:128])
+	default:
+		return ProxyUpgrade{}, false
+	}
+	return upgrade, upgrade.Address != ""
+}
+
+// addressFromWord extracts the address from a 32-byte ABI word in hex.
+func addressFromWord(word string) string {
+	word = strings.TrimPrefix(strings.ToLower(word), "0x")
+	if len(word) < 40 {
+		return ""
+	}
+	return "0x" + word[len(word)-40:]
+}
@@ -0,0 +1,150 @@
+package kaiascan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	testProxy          = "0x00000000000000000000000000000000000000aa"
+	testImplementation = "0x00000000000000000000000000000000000000bb"
+	testNewAdmin       = "0x00000000000000000000000000000000000000cc"
+)
+
+func newProxyServer(t *testing.T) *httptest.Server {
+	word := func(addr string) string {
+		return "0x" + strings.Repeat("0", 24) + strings.TrimPrefix(addr, "0x")
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.Path
+		switch {
+		case strings.HasSuffix(p, "/contracts/creation-code"):
+			code := "0x6080604052" + eip1967AdminSlot + "55" + eip1967ImplementationSlot + "54"
+			w.Write(mockApiResponse(ContractCreationCode{CreationCode: code}, 0, "Success"))
+		case strings.HasSuffix(p, "/contracts/"+testProxy):
+			w.Write(mockApiResponse(ContractInfo{ContractAddress: testProxy}, 0, "Success"))
+		case strings.HasSuffix(p, "/accounts/"+testProxy+"/event-logs"):
+			var logs []EventLog
+			switch r.URL.Query().Get("signature") {
+			case upgradedTopic:
+				logs = []EventLog{
+					{BlockNumber: 10, Topics: []string{upgradedTopic, word("0x0000000000000000000000000000000000000001")}},
+					{BlockNumber: 20, Topics: []string{upgradedTopic, word(testImplementation)}},
+				}
+			case adminChangedTopic:
+				logs = []EventLog{{BlockNumber: 15, Topics: []string{adminChangedTopic}, Data: "0x" + strings.Repeat("0", 64) + strings.TrimPrefix(word(testNewAdmin), "0x")}}
+			}
+			w.Write(mockApiResponse(Page[EventLog]{Paging: Paging{Last: true}, Results: logs}, 0, "Success"))
+		case strings.HasSuffix(p, "/contracts/"+testProxy+"/abi"):
+			w.Write(mockApiResponse(ContractAbi{Abi: Abi{
+				{Type: "constructor"},
+				{Type: "function", Name: "upgradeTo", Inputs: []AbiParam{{Type: "address"}}},
+			}}, 0, "Success"))
+		case strings.HasSuffix(p, "/contracts/"+testImplementation+"/abi"):
+			w.Write(mockApiResponse(ContractAbi{Abi: Abi{
+				{Type: "constructor"},
+				{Type: "function", Name: "balanceOf", Inputs: []AbiParam{{Type: "address"}}},
+			}}, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", p)
+		}
+	}))
+}
+
+func TestResolveImplementation(t *testing.T) {
+	server := newProxyServer(t)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	resolution, err := ResolveImplementation(context.Background(), testProxy)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resolution.Kind != ProxyKindTransparent {
+		t.Errorf("Expected transparent proxy, got %q", resolution.Kind)
+	}
+	if resolution.Implementation != testImplementation {
+		t.Errorf("Expected implementation %s, got %s", testImplementation, resolution.Implementation)
+	}
+	if resolution.Admin != testNewAdmin {
+		t.Errorf("Expected admin %s, got %s", testNewAdmin, resolution.Admin)
+	}
+	if len(resolution.History) != 3 || resolution.History[1].Kind != ProxyUpgradeAdmin {
+		t.Errorf("Unexpected history: %+v", resolution.History)
+	}
+}
+
+func TestGetMergedAbi(t *testing.T) {
+	server := newProxyServer(t)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	abi, err := GetMergedAbi(context.Background(), testProxy)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var signatures []string
+	for _, entry := range abi {
+		signatures = append(signatures, entry.Signature())
+	}
+	if strings.Join(signatures, " ") != "balanceOf(address) constructor upgradeTo(address)" {
+		t.Errorf("Unexpected merged ABI: %v", signatures)
+	}
+}
+
+func TestResolveImplementation_NotAProxy(t *testing.T) {
+	creationCode := func(w http.ResponseWriter) {
+		// A UUPS implementation embeds the EIP-1822 and EIP-1967 slots too.
+		code := "0x6080604052" + eip1822ProxiableSlot + "55" + eip1967ImplementationSlot + "54"
+		w.Write(mockApiResponse(ContractCreationCode{CreationCode: code}, 0, "Success"))
+	}
+	tests := []struct {
+		name         string
+		proxy        bool
+		creationCode func(w http.ResponseWriter)
+		wantErr      bool
+	}{
+		{"uups implementation", false, creationCode, false},
+		{"creation code rate limited", true, func(w http.ResponseWriter) {
+			w.Write(mockApiResponse(ContractCreationCode{}, 429, "Too many requests"))
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				p := r.URL.Path
+				switch {
+				case strings.HasSuffix(p, "/contracts/creation-code"):
+					tt.creationCode(w)
+				case strings.HasSuffix(p, "/contracts/"+testImplementation):
+					w.Write(mockApiResponse(ContractInfo{ContractAddress: testImplementation, Proxy: tt.proxy}, 0, "Success"))
+				case strings.HasSuffix(p, "/event-logs"):
+					w.Write(mockApiResponse(Page[EventLog]{Paging: Paging{Last: true}}, 0, "Success"))
+				default:
+					t.Fatalf("Unexpected API path: %s", p)
+				}
+			}))
+			defer server.Close()
+			BASE_URL = server.URL + "/"
+
+			resolution, err := ResolveImplementation(context.Background(), testImplementation)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected the creation code error to be returned")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if resolution.IsProxy() {
+				t.Errorf("Expected an implementation not to be reported as a proxy, got %q", resolution.Kind)
+			}
+		})
+	}
+}
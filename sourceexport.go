@@ -0,0 +1,155 @@
+package kaiascan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// sourceExportDir keeps exported sources apart from the generated JSON files.
+const sourceExportDir = "sources"
+
+type SourceExportMetadata struct {
+	ChainId              string       `json:"chainId"`
+	ContractAddress      string       `json:"contractAddress"`
+	ContractName         string       `json:"contractName"`
+	CompilerVersion      string       `json:"compilerVersion"`
+	OptimizationEnabled  bool         `json:"optimizationEnabled"`
+	OptimizationRuns     int          `json:"optimizationRuns"`
+	EvmVersion           string       `json:"evmVersion,omitempty"`
+	License              string       `json:"license,omitempty"`
+	SourceFormat         SourceFormat `json:"sourceFormat"`
+	ConstructorArguments string       `json:"constructorArguments,omitempty"`
+	Files                []string     `json:"files"`
+}
+
+// ExportContractSource writes the verified source of a contract into the
+// sources directory under dir, keeping the original file paths, next to
+// abi.json, a standard-input.json that solc can compile directly, and a
+// metadata.json describing the build.
+func ExportContractSource(contractAddress string, dir string) (*SourceExportMetadata, error) {
+	return exportContractSource(NewClient(), contractAddress, dir)
+}
+
+func exportContractSource(api ContractsAPI, contractAddress string, dir string) (*SourceExportMetadata, error) {
+	if contractAddress == "" {
+		return nil, fmt.Errorf("contract address is required")
+	}
+
+	resp, err := api.GetContractSourceCode(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return WriteContractSource(&resp.Data, dir)
+}
+
+func WriteContractSource(source *ContractSourceCode, dir string) (*SourceExportMetadata, error) {
+	bundle, err := source.Bundle()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &SourceExportMetadata{
+		ChainId:              CHAIN_ID,
+		ContractAddress:      source.ContractAddress,
+		ContractName:         source.ContractName,
+		CompilerVersion:      source.CompilerVersion,
+		OptimizationEnabled:  source.OptimizationEnabled,
+		OptimizationRuns:     source.OptimizationRuns,
+		EvmVersion:           source.EvmVersion,
+		License:              source.License,
+		SourceFormat:         bundle.Format,
+		ConstructorArguments: source.ConstructorArguments,
+	}
+
+	sources := map[string]sourceContent{}
+	written := map[string]string{}
+	for _, file := range bundle.Files {
+		rel, err := safeSourcePath(file.Path)
+		if err != nil {
+			return nil, err
+		}
+		rel = path.Join(sourceExportDir, rel)
+		if other, ok := written[strings.ToLower(rel)]; ok {
+			return nil, fmt.Errorf("source paths %q and %q both export to %s", other, file.Path, rel)
+		}
+		written[strings.ToLower(rel)] = file.Path
+		if err := writeExportFile(dir, rel, []byte(file.Content)); err != nil {
+			return nil, err
+		}
+		sources[file.Path] = sourceContent{Content: file.Content}
+		metadata.Files = append(metadata.Files, rel)
+	}
+
+	settings := bundle.Settings
+	if len(settings) == 0 {
+		settings, err = compilerSettings(source)
+		if err != nil {
+			return nil, err
+		}
+	}
+	language := bundle.Language
+	if language == "" {
+		language = "Solidity"
+	}
+
+	outputs := []struct {
+		name  string
+		value any
+	}{
+		{"abi.json", source.Abi},
+		{"standard-input.json", standardJSONInput{Language: language, Sources: sources, Settings: settings}},
+		{"metadata.json", metadata},
+	}
+	for _, out := range outputs {
+		data, err := json.MarshalIndent(out.value, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", out.name, err)
+		}
+		if err := writeExportFile(dir, out.name, append(data, '\n')); err != nil {
+			return nil, err
+		}
+	}
+
+	return metadata, nil
+}
+
+func compilerSettings(source *ContractSourceCode) (json.RawMessage, error) {
+	settings := map[string]any{
+		"optimizer": map[string]any{
+			"enabled": source.OptimizationEnabled,
+			"runs":    source.OptimizationRuns,
+		},
+		"outputSelection": map[string]any{
+			"*": map[string]any{"*": []string{"abi", "evm.bytecode", "evm.deployedBytecode", "metadata"}},
+		},
+	}
+	if source.EvmVersion != "" && !strings.EqualFold(source.EvmVersion, "default") {
+		settings["evmVersion"] = source.EvmVersion
+	}
+	return json.Marshal(settings)
+}
+
+// safeSourcePath turns a source path from the explorer into a relative path
+// that cannot escape the export directory.
+func safeSourcePath(p string) (string, error) {
+	clean := strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(p, "\\", "/")), "/")
+	if clean == "" || clean == "." {
+		return "", fmt.Errorf("invalid source path %q", p)
+	}
+	return clean, nil
+}
+
+func writeExportFile(dir string, rel string, data []byte) error {
+	target := filepath.Join(dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", rel, err)
+	}
+	if err := os.WriteFile(target, data, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", rel, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package kaiascan
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteContractSource(t *testing.T) {
+	dir := t.TempDir()
+	source := &ContractSourceCode{
+		ContractAddress:     "0xcontract",
+		ContractName:        "Vault",
+		CompilerVersion:     "v0.8.24+commit.e11b9ed9",
+		OptimizationEnabled: true,
+		OptimizationRuns:    200,
+		SourceCode:          `{"src/Vault.sol":{"content":"contract Vault {}"},"../../etc/evil.sol":{"content":"contract Evil {}"}}`,
+		Abi:                 Abi{{Type: "function", Name: "deposit"}},
+	}
+
+	metadata, err := WriteContractSource(source, dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if metadata.SourceFormat != SourceFormatMultiFile || len(metadata.Files) != 2 {
+		t.Fatalf("Unexpected metadata: %+v", metadata)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "sources", "src", "Vault.sol"))
+	if err != nil || string(content) != "contract Vault {}" {
+		t.Errorf("Expected Vault.sol to be written, got %q, %v", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "sources", "etc", "evil.sol")); err != nil {
+		t.Errorf("Expected escaping path to be kept inside the export directory: %v", err)
+	}
+
+	var input standardJSONInput
+	data, _ := os.ReadFile(filepath.Join(dir, "standard-input.json"))
+	if err := json.Unmarshal(data, &input); err != nil {
+		t.Fatalf("Expected valid standard-input.json, got %v", err)
+	}
+	if input.Sources["src/Vault.sol"].Content != "contract Vault {}" || len(input.Settings) == 0 {
+		t.Errorf("Unexpected standard input: %+v", input)
+	}
+
+	for _, name := range []string{"abi.json", "metadata.json"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s to be written: %v", name, err)
+		}
+	}
+}
+
+func TestWriteContractSource_Collisions(t *testing.T) {
+	dir := t.TempDir()
+	source := &ContractSourceCode{
+		ContractAddress: "0xcontract",
+		SourceCode:      `{"metadata.json":{"content":"contract Metadata {}"},"abi.json":{"content":"contract Abi {}"}}`,
+		Abi:             Abi{{Type: "function", Name: "deposit"}},
+	}
+	if _, err := WriteContractSource(source, dir); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	content, _ := os.ReadFile(filepath.Join(dir, "sources", "metadata.json"))
+	if string(content) != "contract Metadata {}" {
+		t.Errorf("Expected source named metadata.json to survive, got %q", content)
+	}
+
+	source.SourceCode = `{"src/A.sol":{"content":"contract A {}"},"/src/./A.sol":{"content":"contract B {}"}}`
+	if _, err := WriteContractSource(source, t.TempDir()); err == nil {
+		t.Error("Expected colliding source paths to be rejected")
+	}
+}
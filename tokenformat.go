@@ -0,0 +1,110 @@
+package kaiascan
+
+import (
+	"strings"
+	"sync"
+
+	"kaiascan.go/units"
+)
+
+// maxTokenDecimals bounds the decimals we trust from token metadata; anything
+// outside [0, maxTokenDecimals] is treated as an unknown token.
+const maxTokenDecimals = 77
+
+type TokenAmount struct {
+	TokenAddress string
+	Name         string
+	Symbol       string
+	Decimals     int
+	Raw          units.Amount
+	Known        bool
+}
+
+func (a TokenAmount) Value() string {
+	return units.FormatUnitsExact(a.Raw.Big(), a.Decimals)
+}
+
+func (a TokenAmount) Format(precision int, mode units.RoundingMode) string {
+	return units.FormatUnits(a.Raw.Big(), a.Decimals, precision, mode)
+}
+
+func (a TokenAmount) String() string {
+	if a.Symbol == "" {
+		return a.Value() + " " + a.TokenAddress
+	}
+	return a.Value() + " " + a.Symbol
+}
+
+// TokenMetadataCache resolves token metadata through GetFungibleToken and
+// remembers it, including tokens the API does not know about.
+type TokenMetadataCache struct {
+	// API is where metadata is fetched from. A nil API means a Client.
+	API TokensAPI
+
+	mu     sync.Mutex
+	tokens map[string]*TokenInfo
+}
+
+func NewTokenMetadataCache() *TokenMetadataCache {
+	return &TokenMetadataCache{tokens: map[string]*TokenInfo{}}
+}
+
+// Lookup returns the metadata for tokenAddress, or nil if Kaiascan reports no
+// such fungible token. Only a not-found response is cached as unknown; any
+// other failure is returned and not cached.
+func (c *TokenMetadataCache) Lookup(tokenAddress string) (*TokenInfo, error) {
+	key := strings.ToLower(tokenAddress)
+
+	c.mu.Lock()
+	info, ok := c.tokens[key]
+	c.mu.Unlock()
+	if ok {
+		return info, nil
+	}
+
+	resp, err := apiOrDefault(c.API).GetFungibleToken(tokenAddress)
+	if err != nil {
+		if !isNotFound(err) {
+			return nil, err
+		}
+	} else {
+		info = &resp.Data
+	}
+
+	c.mu.Lock()
+	if c.tokens == nil {
+		c.tokens = map[string]*TokenInfo{}
+	}
+	c.tokens[key] = info
+	c.mu.Unlock()
+	return info, nil
+}
+
+func (c *TokenMetadataCache) FormatAmount(tokenAddress string, raw units.Amount) (*TokenAmount, error) {
+	info, err := c.Lookup(tokenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := &TokenAmount{TokenAddress: tokenAddress, Raw: raw}
+	if info == nil || info.Decimal < 0 || info.Decimal > maxTokenDecimals {
+		return amount, nil
+	}
+	amount.Name = info.Name
+	amount.Symbol = info.Symbol
+	amount.Decimals = int(info.Decimal)
+	amount.Known = true
+	return amount, nil
+}
+
+func (c *TokenMetadataCache) FormatBalances(balances []TokenBalance) ([]TokenAmount, error) {
+	amounts := make([]TokenAmount, 0, len(balances))
+	for _, balance := range balances {
+		amount, err := c.FormatAmount(balance.ContractAddress, balance.Balance)
+		if err != nil {
+			return nil, err
+		}
+		amounts = append(amounts, *amount)
+	}
+	return amounts, nil
+}
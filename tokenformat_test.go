@@ -0,0 +1,114 @@
+package kaiascan
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kaiascan.go/units"
+)
+
+func TestTokenMetadataCache_FormatAmount(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Query().Get("tokenAddress") {
+		case "0xusdt":
+			w.Write(mockApiResponse(TokenInfo{Name: "Tether", Symbol: "USDT", Decimal: 6}, 0, "Success"))
+		case "0xpoints":
+			w.Write(mockApiResponse(TokenInfo{Name: "Points", Symbol: "PTS", Decimal: 0}, 0, "Success"))
+		default:
+			w.Write(mockApiResponse(TokenInfo{}, 404, "token not found"))
+		}
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	cache := NewTokenMetadataCache()
+
+	amount, err := cache.FormatAmount("0xusdt", units.NewAmount(big.NewInt(1500000)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if amount.String() != "1.5 USDT" || !amount.Known {
+		t.Errorf("Expected '1.5 USDT', got %q", amount.String())
+	}
+	if got := amount.Format(2, units.RoundDown); got != "1.50" {
+		t.Errorf("Expected '1.50', got %q", got)
+	}
+
+	if _, err := cache.FormatAmount("0xUSDT", units.NewAmount(big.NewInt(1))); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected token metadata to be cached, got %d requests", requests)
+	}
+
+	amount, _ = cache.FormatAmount("0xpoints", units.NewAmount(big.NewInt(42)))
+	if amount.String() != "42 PTS" {
+		t.Errorf("Expected '42 PTS', got %q", amount.String())
+	}
+
+	amount, err = cache.FormatAmount("0xunknown", units.NewAmount(big.NewInt(7)))
+	if err != nil {
+		t.Fatalf("Expected unknown token to format without error, got %v", err)
+	}
+	if amount.Known || amount.String() != "7 0xunknown" {
+		t.Errorf("Unexpected unknown token amount: %+v", amount)
+	}
+}
+
+func TestTokenMetadataCache_TransientErrors(t *testing.T) {
+	failures := 2
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case failures == 2:
+			w.Write(mockApiResponse(TokenInfo{}, 429, "rate limited"))
+		case failures == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write(mockApiResponse(TokenInfo{Symbol: "USDT", Decimal: 6}, 0, "Success"))
+		}
+		failures--
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	cache := NewTokenMetadataCache()
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Lookup("0xusdt"); err == nil {
+			t.Fatalf("Expected attempt %d to fail", i+1)
+		}
+	}
+	info, err := cache.Lookup("0xusdt")
+	if err != nil || info == nil || info.Symbol != "USDT" {
+		t.Fatalf("Expected token metadata after transient failures, got %+v, %v", info, err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected failures not to be cached, got %d requests", requests)
+	}
+}
+
+func TestTokenMetadataCache_ZeroValue(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(mockApiResponse(TokenInfo{Symbol: "USDT", Decimal: 6}, 0, "Success"))
+	}))
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	var cache TokenMetadataCache
+	for i := 0; i < 2; i++ {
+		info, err := cache.Lookup("0xusdt")
+		if err != nil || info == nil || info.Symbol != "USDT" {
+			t.Fatalf("Unexpected lookup: %+v, %v", info, err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the zero value to cache lookups, got %d requests", requests)
+	}
+}
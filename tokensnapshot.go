@@ -0,0 +1,200 @@
+package kaiascan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"kaiascan.go/units"
+)
+
+type TokenHolderShare struct {
+	Address    string       `json:"address"`
+	Balance    units.Amount `json:"balance"`
+	Percentage float64      `json:"percentage"`
+}
+
+type TokenHolderMismatch struct {
+	Address  string       `json:"address"`
+	Replayed units.Amount `json:"replayed"`
+	Reported units.Amount `json:"reported"`
+}
+
+type TokenHolderSnapshot struct {
+	TokenAddress string                `json:"tokenAddress"`
+	BlockNumber  int                   `json:"blockNumber"`
+	TotalSupply  units.Amount          `json:"totalSupply"`
+	Holders      []TokenHolderShare    `json:"holders"`
+	Checked      bool                  `json:"checked"`
+	Validated    bool                  `json:"validated"`
+	Mismatches   []TokenHolderMismatch `json:"mismatches,omitempty"`
+}
+
+// SnapshotTokenHolders reconstructs token balances at blockNumber by
+// replaying every transfer and burn up to that block. When blockNumber is at
+// or past the chain head the result is compared with the current holder list
+// and any differences are reported in Mismatches; Checked records that the
+// comparison ran and Validated that it found no differences. Holders are
+// sorted by descending balance.
+func SnapshotTokenHolders(ctx context.Context, tokenAddress string, blockNumber int) (*TokenHolderSnapshot, error) {
+	return snapshotTokenHolders(ctx, NewClient(), tokenAddress, blockNumber)
+}
+
+func snapshotTokenHolders(ctx context.Context, api interface {
+	BlocksAPI
+	TokensAPI
+}, tokenAddress string, blockNumber int) (*TokenHolderSnapshot, error) {
+	if tokenAddress == "" {
+		return nil, fmt.Errorf("token address is required")
+	}
+
+	balances, err := replayTokenBalances(ctx, api, tokenAddress, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &TokenHolderSnapshot{TokenAddress: tokenAddress, BlockNumber: blockNumber}
+	snapshot.Holders, snapshot.TotalSupply = tokenHolderShares(balances)
+
+	latest, err := api.GetLatestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest block: %w", err)
+	}
+	if int64(blockNumber) >= latest.Data.BlockNumber {
+		snapshot.Mismatches, err = compareWithCurrentHolders(ctx, api, tokenAddress, balances)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Checked = true
+		snapshot.Validated = len(snapshot.Mismatches) == 0
+	}
+
+	return snapshot, nil
+}
+
+func replayTokenBalances(ctx context.Context, api TokensAPI, tokenAddress string, blockNumber int) (map[string]*big.Int, error) {
+	balances := map[string]*big.Int{}
+	credit := func(address string, amount *big.Int) {
+		address = strings.ToLower(address)
+		if address == "" || address == zeroAddress {
+			return
+		}
+		if balances[address] == nil {
+			balances[address] = new(big.Int)
+		}
+		balances[address].Add(balances[address], amount)
+	}
+
+	seen := map[string]bool{}
+	err := forEachPage(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenTransfer]], error) {
+		return api.GetTokenTransfers(tokenAddress, page, size, nil, &blockNumber)
+	}, func(transfers []TokenTransfer) error {
+		for _, t := range transfers {
+			if t.BlockNumber > int64(blockNumber) {
+				continue
+			}
+			seen[logKey(t.TransactionHash, t.LogIndex)] = true
+			amount := t.Amount.Big()
+			credit(t.From, new(big.Int).Neg(amount))
+			credit(t.To, amount)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token transfers: %w", err)
+	}
+
+	// Burns that are plain transfers to the zero address were already
+	// replayed above; only apply the ones the transfer list does not contain.
+	err = forEachPage(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenBurn]], error) {
+		return api.GetTokenBurns(tokenAddress, page, size, nil, &blockNumber)
+	}, func(burns []TokenBurn) error {
+		for _, b := range burns {
+			if b.BlockNumber > int64(blockNumber) || seen[logKey(b.TransactionHash, b.LogIndex)] {
+				continue
+			}
+			credit(b.From, new(big.Int).Neg(b.Amount.Big()))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token burns: %w", err)
+	}
+
+	return balances, nil
+}
+
+func tokenHolderShares(balances map[string]*big.Int) ([]TokenHolderShare, units.Amount) {
+	total := new(big.Int)
+	holders := []TokenHolderShare{}
+	for address, balance := range balances {
+		if balance.Sign() <= 0 {
+			continue
+		}
+		total.Add(total, balance)
+		holders = append(holders, TokenHolderShare{Address: address, Balance: units.NewAmount(balance)})
+	}
+
+	sort.Slice(holders, func(i, j int) bool {
+		if c := holders[i].Balance.Cmp(holders[j].Balance); c != 0 {
+			return c > 0
+		}
+		return holders[i].Address < holders[j].Address
+	})
+
+	for i := range holders {
+		holders[i].Percentage = percentage(holders[i].Balance.Big(), total)
+	}
+	return holders, units.NewAmount(total)
+}
+
+func compareWithCurrentHolders(ctx context.Context, api TokensAPI, tokenAddress string, balances map[string]*big.Int) ([]TokenHolderMismatch, error) {
+	reported := map[string]*big.Int{}
+	err := forEachPage(ctx, maxPageSize, func(page int, size int) (*ApiResponse[Page[TokenHolder]], error) {
+		return api.GetTokenHolders(tokenAddress, page, size, nil)
+	}, func(holders []TokenHolder) error {
+		for _, h := range holders {
+			reported[strings.ToLower(h.HolderAddress)] = h.Amount.Big()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching current token holders: %w", err)
+	}
+
+	addresses := map[string]bool{}
+	for address, balance := range balances {
+		if balance.Sign() > 0 {
+			addresses[address] = true
+		}
+	}
+	for address := range reported {
+		addresses[address] = true
+	}
+
+	var mismatches []TokenHolderMismatch
+	for address := range addresses {
+		replayed, current := balances[address], reported[address]
+		if replayed == nil || replayed.Sign() < 0 {
+			replayed = new(big.Int)
+		}
+		if current == nil {
+			current = new(big.Int)
+		}
+		if replayed.Cmp(current) != 0 {
+			mismatches = append(mismatches, TokenHolderMismatch{
+				Address:  address,
+				Replayed: units.NewAmount(replayed),
+				Reported: units.NewAmount(current),
+			})
+		}
+	}
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].Address < mismatches[j].Address })
+	return mismatches, nil
+}
+
+func logKey(transactionHash string, logIndex int) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(transactionHash), logIndex)
+}
@@ -0,0 +1,88 @@
+package kaiascan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTokenHistoryServer(t *testing.T, head int64, holders []TokenHolder) *httptest.Server {
+	transfers := []TokenTransfer{
+		{TransactionHash: "0x1", BlockNumber: 10, From: zeroAddress, To: "0xAlice", Amount: amountOf(1000)},
+		{TransactionHash: "0x2", BlockNumber: 20, From: "0xAlice", To: "0xBob", Amount: amountOf(300)},
+		{TransactionHash: "0x3", BlockNumber: 30, From: "0xBob", To: zeroAddress, Amount: amountOf(100)},
+		{TransactionHash: "0x5", BlockNumber: 50, From: "0xAlice", To: "0xCarol", Amount: amountOf(200)},
+	}
+	burns := []TokenBurn{
+		{TransactionHash: "0x3", BlockNumber: 30, From: "0xBob", Amount: amountOf(100)},
+		{TransactionHash: "0x4", BlockNumber: 40, From: "0xAlice", Amount: amountOf(100)},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/tokens/0xtoken/transfers"):
+			w.Write(mockApiResponse(Page[TokenTransfer]{Paging: Paging{Last: true}, Results: transfers}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/tokens/0xtoken/burns"):
+			w.Write(mockApiResponse(Page[TokenBurn]{Paging: Paging{Last: true}, Results: burns}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/tokens/0xtoken/holders"):
+			w.Write(mockApiResponse(Page[TokenHolder]{Paging: Paging{Last: true}, Results: holders}, 0, "Success"))
+		case strings.HasSuffix(r.URL.Path, "/blocks/latest"):
+			w.Write(mockApiResponse(Block{BlockNumber: head}, 0, "Success"))
+		default:
+			t.Fatalf("Unexpected API path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestSnapshotTokenHolders_Historical(t *testing.T) {
+	server := newTokenHistoryServer(t, 1000, nil)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	snapshot, err := SnapshotTokenHolders(context.Background(), "0xtoken", 45)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if snapshot.Checked || snapshot.Validated {
+		t.Error("Expected a historical snapshot not to be checked")
+	}
+	if snapshot.TotalSupply.String() != "800" || len(snapshot.Holders) != 2 {
+		t.Fatalf("Unexpected snapshot: %+v", snapshot)
+	}
+	alice := snapshot.Holders[0]
+	if alice.Address != "0xalice" || alice.Balance.String() != "600" || alice.Percentage != 75 {
+		t.Errorf("Unexpected top holder: %+v", alice)
+	}
+}
+
+func TestSnapshotTokenHolders_ValidatesAtHead(t *testing.T) {
+	holders := []TokenHolder{
+		{HolderAddress: "0xalice", Amount: amountOf(400)},
+		{HolderAddress: "0xbob", Amount: amountOf(200)},
+		{HolderAddress: "0xcarol", Amount: amountOf(150)},
+	}
+	server := newTokenHistoryServer(t, 60, holders)
+	defer server.Close()
+	BASE_URL = server.URL + "/"
+
+	snapshot, err := SnapshotTokenHolders(context.Background(), "0xtoken", 60)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !snapshot.Checked || snapshot.Validated {
+		t.Fatal("Expected a head snapshot with mismatches to be checked but not validated")
+	}
+	if len(snapshot.Mismatches) != 1 || snapshot.Mismatches[0].Address != "0xcarol" || snapshot.Mismatches[0].Replayed.String() != "200" {
+		t.Errorf("Unexpected mismatches: %+v", snapshot.Mismatches)
+	}
+	holders[2].Amount = amountOf(200)
+	snapshot, err = SnapshotTokenHolders(context.Background(), "0xtoken", 60)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !snapshot.Checked || !snapshot.Validated || len(snapshot.Mismatches) != 0 {
+		t.Errorf("Expected a matching head snapshot to be validated: %+v", snapshot)
+	}
+}
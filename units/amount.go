@@ -0,0 +1,110 @@
+package units
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrFractionalAmount is returned when decoding an Amount from a value with a
+// fractional part, which cannot be held exactly in the smallest denomination.
+var ErrFractionalAmount = errors.New("fractional amount")
+
+// Amount is an integer amount in the smallest denomination of a currency,
+// kei for KAIA. It decodes from JSON strings, hex strings and numbers without
+// losing precision. Decimal values such as "1.5" are rejected with
+// ErrFractionalAmount rather than rounded.
+type Amount struct {
+	v *big.Int
+}
+
+func NewAmount(v *big.Int) Amount {
+	if v == nil {
+		return Amount{}
+	}
+	return Amount{v: new(big.Int).Set(v)}
+}
+
+func (a Amount) Big() *big.Int {
+	if a.v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(a.v)
+}
+
+func (a Amount) Sign() int {
+	if a.v == nil {
+		return 0
+	}
+	return a.v.Sign()
+}
+
+func (a Amount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+func (a Amount) Cmp(b Amount) int {
+	return a.Big().Cmp(b.Big())
+}
+
+func (a Amount) Add(b Amount) Amount {
+	return Amount{v: new(big.Int).Add(a.Big(), b.Big())}
+}
+
+func (a Amount) Sub(b Amount) Amount {
+	return Amount{v: new(big.Int).Sub(a.Big(), b.Big())}
+}
+
+func (a Amount) String() string {
+	return a.Big().String()
+}
+
+func (a Amount) Format(u Unit, precision int, mode RoundingMode) string {
+	return Format(a.Big(), u, precision, mode)
+}
+
+func (a Amount) FormatExact(u Unit) string {
+	return FormatExact(a.Big(), u)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		a.v = nil
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		s = strings.TrimSpace(s)
+	}
+	if s == "" {
+		a.v = nil
+		return nil
+	}
+
+	v, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		_, ok = v.SetString(s[2:], 16)
+	} else {
+		_, ok = v.SetString(s, 10)
+	}
+	if !ok && strings.Contains(s, ".") {
+		return fmt.Errorf("%w %s, expected an integer in the smallest denomination", ErrFractionalAmount, data)
+	}
+	if !ok {
+		return fmt.Errorf("invalid integer amount %s", data)
+	}
+	a.v = v
+	return nil
+}
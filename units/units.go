@@ -0,0 +1,215 @@
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Unit is a KAIA denomination, expressed as its power-of-ten exponent
+// relative to kei.
+type Unit int
+
+const (
+	Kei  Unit = 0
+	Ston Unit = 9
+	KAIA Unit = 18
+)
+
+func (u Unit) String() string {
+	switch u {
+	case Kei:
+		return "kei"
+	case Ston:
+		return "ston"
+	case KAIA:
+		return "KAIA"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+func ParseUnit(s string) (Unit, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "kei", "peb":
+		return Kei, nil
+	case "ston", "gkei":
+		return Ston, nil
+	case "kaia", "klay":
+		return KAIA, nil
+	default:
+		return 0, fmt.Errorf("unknown unit %q", s)
+	}
+}
+
+type RoundingMode int
+
+const (
+	RoundDown RoundingMode = iota
+	RoundUp
+	RoundHalfUp
+	RoundHalfEven
+)
+
+// Parse converts a human amount such as "1.5 KAIA", "25 ston" or "100kei"
+// into kei. The unit is required.
+func Parse(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+' && r != '_' && r != ','
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("missing unit in amount %q", s)
+	}
+
+	unit, err := ParseUnit(s[i:])
+	if err != nil {
+		return nil, err
+	}
+	return ToKei(strings.TrimSpace(s[:i]), unit)
+}
+
+// ToKei converts a decimal string in the given unit into kei. It fails if the
+// amount has more fractional digits than the unit allows.
+func ToKei(amount string, u Unit) (*big.Int, error) {
+	return ParseUnits(amount, int(u))
+}
+
+func FromKei(kei *big.Int, u Unit) *big.Rat {
+	return new(big.Rat).SetFrac(kei, pow10(int(u)))
+}
+
+// ParseUnits converts a decimal string into an integer amount of the
+// smallest denomination of a currency with the given number of decimals.
+// Digits may be grouped with _; a comma is rejected because it is a decimal
+// separator in some locales and a thousands separator in others.
+func ParseUnits(amount string, decimals int) (*big.Int, error) {
+	clean := strings.ReplaceAll(strings.TrimSpace(amount), "_", "")
+	if clean == "" {
+		return nil, fmt.Errorf("empty amount")
+	}
+	if strings.Contains(clean, ",") {
+		return nil, fmt.Errorf("invalid amount %q, use . for decimals and _ to group digits", amount)
+	}
+
+	negative := false
+	switch clean[0] {
+	case '-':
+		negative = true
+		clean = clean[1:]
+	case '+':
+		clean = clean[1:]
+	}
+
+	whole, frac, _ := strings.Cut(clean, ".")
+	if whole == "" && frac == "" {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > decimals {
+		return nil, fmt.Errorf("amount %q has more than %d decimal places", amount, decimals)
+	}
+
+	digits := whole + frac + strings.Repeat("0", decimals-len(frac))
+	if strings.TrimLeft(digits, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	if negative {
+		value.Neg(value)
+	}
+	return value, nil
+}
+
+func Format(kei *big.Int, u Unit, precision int, mode RoundingMode) string {
+	return FormatUnits(kei, int(u), precision, mode)
+}
+
+func FormatExact(kei *big.Int, u Unit) string {
+	return FormatUnitsExact(kei, int(u))
+}
+
+// FormatUnits renders value, an integer amount with the given number of
+// decimals, with exactly precision fractional digits.
+func FormatUnits(value *big.Int, decimals int, precision int, mode RoundingMode) string {
+	if value == nil {
+		value = new(big.Int)
+	}
+	if precision < 0 {
+		precision = 0
+	}
+
+	scaled := new(big.Int).Set(value)
+	if precision < decimals {
+		scaled = roundDiv(scaled, pow10(decimals-precision), mode)
+	} else {
+		scaled.Mul(scaled, pow10(precision-decimals))
+	}
+	return insertPoint(scaled, precision)
+}
+
+// FormatUnitsExact renders value with as many fractional digits as needed to
+// be exact, and no trailing zeros.
+func FormatUnitsExact(value *big.Int, decimals int) string {
+	if value == nil {
+		value = new(big.Int)
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	s := insertPoint(value, decimals)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+func insertPoint(value *big.Int, decimals int) string {
+	digits := new(big.Int).Abs(value).String()
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+}
+
+func roundDiv(value *big.Int, divisor *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(value, divisor, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	step := big.NewInt(int64(value.Sign()))
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	half := twice.Cmp(divisor)
+
+	switch mode {
+	case RoundUp:
+		quo.Add(quo, step)
+	case RoundHalfUp:
+		if half >= 0 {
+			quo.Add(quo, step)
+		}
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && quo.Bit(0) == 1) {
+			quo.Add(quo, step)
+		}
+	}
+	return quo
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
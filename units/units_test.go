@@ -0,0 +1,87 @@
+package units
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]string{
+		"1.5 KAIA":      "1500000000000000000",
+		"25 ston":       "25000000000",
+		"100kei":        "100",
+		"0.000001 kaia": "1000000000000",
+		"-2 KAIA":       "-2000000000000000000",
+		"1_000 ston":    "1000000000000",
+	}
+	for input, want := range cases {
+		got, err := Parse(input)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", input, err)
+			continue
+		}
+		if got.String() != want {
+			t.Errorf("Parse(%q) = %s, want %s", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"1.5", "1.5 kei", "abc KAIA", "1 wei", "1,5 KAIA", "1,000 ston"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Expected Parse(%q) to fail", input)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	kei, _ := new(big.Int).SetString("1234567890000000000", 10)
+
+	if got := Format(kei, KAIA, 2, RoundDown); got != "1.23" {
+		t.Errorf("RoundDown: got %s", got)
+	}
+	if got := Format(kei, KAIA, 2, RoundUp); got != "1.24" {
+		t.Errorf("RoundUp: got %s", got)
+	}
+	if got := Format(kei, Ston, 0, RoundHalfUp); got != "1234567890" {
+		t.Errorf("Ston: got %s", got)
+	}
+	if got := FormatExact(kei, KAIA); got != "1.23456789" {
+		t.Errorf("FormatExact: got %s", got)
+	}
+	if got := FormatUnits(big.NewInt(25), 1, 0, RoundHalfEven); got != "2" {
+		t.Errorf("RoundHalfEven 2.5: got %s", got)
+	}
+	if got := FormatUnits(big.NewInt(-35), 1, 0, RoundHalfEven); got != "-4" {
+		t.Errorf("RoundHalfEven -3.5: got %s", got)
+	}
+	if got := FormatUnits(big.NewInt(5), 3, 4, RoundDown); got != "0.0050" {
+		t.Errorf("Padding: got %s", got)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var v struct {
+		A Amount `json:"a"`
+		B Amount `json:"b"`
+		C Amount `json:"c"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"123456789012345678901234567890","b":42,"c":"0xff"}`), &v); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if v.A.String() != "123456789012345678901234567890" || v.B.String() != "42" || v.C.String() != "255" {
+		t.Errorf("Unexpected amounts: %s %s %s", v.A, v.B, v.C)
+	}
+
+	for _, input := range []string{`"1.5"`, `1.5`} {
+		var a Amount
+		if err := json.Unmarshal([]byte(input), &a); !errors.Is(err, ErrFractionalAmount) {
+			t.Errorf("Expected ErrFractionalAmount for %s, got %v", input, err)
+		}
+	}
+
+	out, _ := json.Marshal(v.B)
+	if string(out) != `"42"` {
+		t.Errorf("Expected quoted amount, got %s", out)
+	}
+}